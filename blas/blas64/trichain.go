@@ -0,0 +1,45 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas64
+
+import "gonum.org/v1/gonum/blas"
+
+// TriangularOp is a single factor op(A) of a chain of triangular
+// matrix-vector products. If IsBand is true the factor is Band, otherwise it
+// is Tri.
+type TriangularOp struct {
+	Trans  blas.Transpose
+	IsBand bool
+	Tri    Triangular
+	Band   TriangularBand
+}
+
+// ApplyTriChain computes
+//  x = op(A_k) * ... * op(A_2) * op(A_1) * x
+// where ops[0] holds op(A_1) and ops[len(ops)-1] holds op(A_k), in place in x.
+// Each factor is applied with Trmv or Tbmv, so no intermediate storage is
+// needed.
+//
+// ApplyTriChain will panic if the order of any factor does not match the
+// length of x. All factors are checked before any is applied, so x is left
+// unmodified when ApplyTriChain panics.
+func ApplyTriChain(ops []TriangularOp, x Vector) {
+	for _, op := range ops {
+		n := op.Tri.N
+		if op.IsBand {
+			n = op.Band.N
+		}
+		if n != x.N {
+			panic(badLength)
+		}
+	}
+	for _, op := range ops {
+		if op.IsBand {
+			Tbmv(op.Trans, op.Band, x)
+			continue
+		}
+		Trmv(op.Trans, op.Tri, x)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas64
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestApplyTriChain(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10} {
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+					a1 := randTriangular(ul, d, n, rnd)
+					a2 := randTriangular(ul, d, n, rnd)
+
+					// Form op(A_2)*op(A_1) explicitly as op(A_2*A_1)
+					// or op(A_1*A_2) depending on the transpose. For
+					// matching triangles the product is triangular
+					// with the same shape, and the product of two unit
+					// triangular matrices is unit triangular.
+					left, right := a2, a1
+					if tA != blas.NoTrans {
+						left, right = a1, a2
+					}
+					p := General{Rows: n, Cols: n, Data: make([]float64, n*n), Stride: n}
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							p.Data[i*n+j] = triAt(right, i, j)
+						}
+					}
+					Trmm(blas.Left, blas.NoTrans, 1, left, p)
+					prod := Triangular{Uplo: ul, Diag: d, N: n, Data: p.Data, Stride: n}
+
+					x := Vector{N: n, Data: make([]float64, n), Inc: 1}
+					for i := range x.Data {
+						x.Data[i] = rnd.NormFloat64()
+					}
+					want := Vector{N: n, Data: make([]float64, n), Inc: 1}
+					copy(want.Data, x.Data)
+					Trmv(tA, prod, want)
+
+					ApplyTriChain([]TriangularOp{
+						{Trans: tA, Tri: a1},
+						{Trans: tA, IsBand: true, Band: triToBand(a2)},
+					}, x)
+
+					if !floats.EqualApprox(x.Data, want.Data, tol) {
+						t.Errorf("n=%v,ul=%v,tA=%v,d=%v: unexpected result\nwant %v\ngot  %v",
+							n, ul, tA, d, want.Data, x.Data)
+					}
+				}
+			}
+		}
+	}
+}
+
+func randTriangular(ul blas.Uplo, d blas.Diag, n int, rnd *rand.Rand) Triangular {
+	a := Triangular{Uplo: ul, Diag: d, N: n, Data: make([]float64, n*n), Stride: n}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i) {
+				a.Data[i*n+j] = rnd.NormFloat64()
+			}
+		}
+	}
+	return a
+}
+
+// triAt returns the (i, j) element of the triangular matrix a, taking into
+// account its triangle and diagonal kind.
+func triAt(a Triangular, i, j int) float64 {
+	switch {
+	case i == j && a.Diag == blas.Unit:
+		return 1
+	case a.Uplo == blas.Upper && j < i, a.Uplo == blas.Lower && j > i:
+		return 0
+	}
+	return a.Data[i*a.Stride+j]
+}
+
+// triToBand returns a in band storage with n-1 off-diagonals.
+func triToBand(a Triangular) TriangularBand {
+	n := a.N
+	k := max(0, n-1)
+	b := TriangularBand{Uplo: a.Uplo, Diag: a.Diag, N: n, K: k, Data: make([]float64, n*(k+1)), Stride: k + 1}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (a.Uplo == blas.Upper && j < i) || (a.Uplo == blas.Lower && j > i) {
+				continue
+			}
+			c := j - i
+			if a.Uplo == blas.Lower {
+				c += k
+			}
+			b.Data[i*b.Stride+c] = a.Data[i*a.Stride+j]
+		}
+	}
+	return b
+}
+
+func TestApplyTriChainBadLength(t *testing.T) {
+	const n = 4
+	rnd := rand.New(rand.NewSource(1))
+	x := Vector{N: n, Data: make([]float64, n), Inc: 1}
+	for i := range x.Data {
+		x.Data[i] = rnd.NormFloat64()
+	}
+	want := make([]float64, n)
+	copy(want, x.Data)
+
+	// Only the last factor has the wrong order, so a chain that applied
+	// factors before checking them would have modified x.
+	panicked := func() (b bool) {
+		defer func() { b = recover() != nil }()
+		ApplyTriChain([]TriangularOp{
+			{Trans: blas.NoTrans, Tri: randTriangular(blas.Upper, blas.NonUnit, n, rnd)},
+			{Trans: blas.NoTrans, IsBand: true, Band: triToBand(randTriangular(blas.Lower, blas.NonUnit, n+1, rnd))},
+		}, x)
+		return
+	}()
+	if !panicked {
+		t.Error("no panic for mismatched factor order")
+	}
+	if !floats.Same(x.Data, want) {
+		t.Errorf("x modified before panic\nwant %v\ngot  %v", want, x.Data)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas64
+
+import "gonum.org/v1/gonum/blas"
+
+// TriOp specifies whether ApplyTri multiplies by a triangular matrix or
+// solves a system with it.
+type TriOp byte
+
+const (
+	// Multiply computes x = op(A) * x.
+	Multiply TriOp = iota
+	// Solve computes x = op(A)⁻¹ * x.
+	Solve
+)
+
+const badTriOp = "blas64: illegal triangular operation"
+
+// ApplyTri computes
+//  x = op(A) * x    if op == Multiply,
+//  x = op(A)⁻¹ * x  if op == Solve,
+// where A is an n×n triangular matrix, op(A) is A or Aᵀ according to t, and x
+// is a vector. ApplyTri dispatches to Trmv or Trsv, so code applying a
+// triangular preconditioner M or its inverse can select between the two with
+// a parameter.
+//
+// No test for singularity or near-singularity is included when op is Solve.
+// Such tests must be performed before calling this routine.
+func ApplyTri(op TriOp, t blas.Transpose, a Triangular, x Vector) {
+	switch op {
+	default:
+		panic(badTriOp)
+	case Multiply:
+		Trmv(t, a, x)
+	case Solve:
+		Trsv(t, a, x)
+	}
+}
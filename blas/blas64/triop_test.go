@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas64
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestApplyTri(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10} {
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+					for _, inc := range []int{1, 3} {
+						a := randTriangular(ul, d, n, rnd)
+						// Keep the diagonal away from zero so that A
+						// is well conditioned.
+						for i := 0; i < n; i++ {
+							a.Data[i*a.Stride+i] = 2 + rnd.Float64()
+						}
+						x := Vector{N: n, Data: make([]float64, 1+(n-1)*inc), Inc: inc}
+						for i := range x.Data {
+							x.Data[i] = rnd.NormFloat64()
+						}
+						want := make([]float64, len(x.Data))
+						copy(want, x.Data)
+
+						ApplyTri(Multiply, tA, a, x)
+						ApplyTri(Solve, tA, a, x)
+
+						name := fmt.Sprintf("n=%v,ul=%v,tA=%v,d=%v,inc=%v", n, ul, tA, d, inc)
+						if !floats.EqualApprox(x.Data, want, tol) {
+							t.Errorf("%v: Multiply followed by Solve is not the identity\nwant %v\ngot  %v",
+								name, want, x.Data)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	panicked := func() (b bool) {
+		defer func() { b = recover() != nil }()
+		ApplyTri(Solve+1, blas.NoTrans, Triangular{}, Vector{})
+		return
+	}()
+	if !panicked {
+		t.Error("no panic for illegal TriOp")
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// CSR is an m×n sparse matrix in compressed sparse row format. The non-zero
+// elements of row i are stored in Value[RowPtr[i]:RowPtr[i+1]] with their
+// column indices, in increasing order, in the same positions of ColIdx.
+// RowPtr has length m+1 and RowPtr[0] is zero.
+type CSR struct {
+	Rows, Cols int
+
+	RowPtr []int
+	ColIdx []int
+	Value  []float64
+}
+
+// DgbToCSR returns the m×n band matrix A with kL sub-diagonals and kU
+// super-diagonals, stored in a in the layout used by Dgbmv, converted to
+// compressed sparse row format. Zero elements within the band are not stored.
+//
+// Applying the result with DcsrMV costs time proportional to the number of
+// non-zero elements instead of to the size of the band, which pays off when
+// most elements of the band are zero.
+func (Implementation) DgbToCSR(m, n, kL, kU int, a []float64, lda int) CSR {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if kL < 0 {
+		panic(kLLT0)
+	}
+	if kU < 0 {
+		panic(kULT0)
+	}
+	if lda < kL+kU+1 {
+		panic(badLdA)
+	}
+
+	csr := CSR{
+		Rows:   m,
+		Cols:   n,
+		RowPtr: make([]int, m+1),
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return csr
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
+		panic(shortA)
+	}
+
+	for i := 0; i < m; i++ {
+		off := max(0, kL-i)
+		jStart := max(0, i-kL)
+		jEnd := min(n, i+kU+1)
+		if jStart < jEnd {
+			for j, v := range a[i*lda+off : i*lda+off+jEnd-jStart] {
+				if v != 0 {
+					csr.ColIdx = append(csr.ColIdx, jStart+j)
+					csr.Value = append(csr.Value, v)
+				}
+			}
+		}
+		csr.RowPtr[i+1] = len(csr.Value)
+	}
+	return csr
+}
+
+// DcsrMV performs one of the matrix-vector operations
+//  y = alpha * A * x + beta * y   if tA == blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA == blas.Trans or blas.ConjTrans
+// where A is the sparse matrix c, x and y are vectors, and alpha and beta are
+// scalars. As for Dgbmv, y is set to zero without being read when beta is zero.
+//
+// Elements of A that are not stored are not multiplied, so NaN and infinite
+// elements of x do not propagate through them as they would through the
+// explicit zeros of a band matrix passed to Dgbmv.
+//
+// DcsrMV will panic if c is malformed, that is if RowPtr does not have length
+// m+1, does not start at zero or decreases, if ColIdx or Value are shorter
+// than RowPtr[m], or if a stored column index is outside [0, n).
+func (Implementation) DcsrMV(tA blas.Transpose, alpha float64, c CSR, x []float64, incX int, beta float64, y []float64, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	m, n := c.Rows, c.Cols
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if len(c.RowPtr) != m+1 || c.RowPtr[0] != 0 || len(c.ColIdx) < c.RowPtr[m] || len(c.Value) < c.RowPtr[m] {
+		panic(badCSR)
+	}
+	for i := 0; i < m; i++ {
+		if c.RowPtr[i+1] < c.RowPtr[i] {
+			panic(badCSR)
+		}
+	}
+	for _, j := range c.ColIdx[:c.RowPtr[m]] {
+		if j < 0 || n <= j {
+			panic(badCSR)
+		}
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = -(lenX - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(lenY - 1) * incY
+	}
+
+	// Form y = beta * y.
+	dscalePrepY(lenY, beta, y, incY)
+
+	if alpha == 0 {
+		return
+	}
+
+	if tA == blas.NoTrans {
+		iy := ky
+		for i := 0; i < m; i++ {
+			var sum float64
+			cols := c.ColIdx[c.RowPtr[i]:c.RowPtr[i+1]]
+			for k, v := range c.Value[c.RowPtr[i]:c.RowPtr[i+1]] {
+				sum += v * x[kx+cols[k]*incX]
+			}
+			y[iy] += alpha * sum
+			iy += incY
+		}
+		return
+	}
+	ix := kx
+	for i := 0; i < m; i++ {
+		tmp := alpha * x[ix]
+		cols := c.ColIdx[c.RowPtr[i]:c.RowPtr[i+1]]
+		for k, v := range c.Value[c.RowPtr[i]:c.RowPtr[i+1]] {
+			y[ky+cols[k]*incY] += tmp * v
+		}
+		ix += incX
+	}
+}
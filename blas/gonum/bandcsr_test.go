@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDcsrMV(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {1, 4}, {5, 1}, {6, 6}, {9, 7}, {20, 25}} {
+		m, n := dims[0], dims[1]
+		for _, k := range [][2]int{{0, 0}, {1, 1}, {3, 0}, {0, 4}, {5, 3}} {
+			kL, kU := k[0], k[1]
+			lda := kL + kU + 2
+			aDense := randBandDense(rnd, m, n, kL, kU)
+			// Keep about one in five elements of the band.
+			var nnz int
+			for i, v := range aDense {
+				if v != 0 && rnd.Intn(5) != 0 {
+					aDense[i] = 0
+				}
+				if aDense[i] != 0 {
+					nnz++
+				}
+			}
+			a := denseToBand(m, n, kL, kU, aDense, lda, math.NaN())
+
+			name := fmt.Sprintf("m=%v,n=%v,kL=%v,kU=%v", m, n, kL, kU)
+			csr := impl.DgbToCSR(m, n, kL, kU, a, lda)
+			if len(csr.Value) != nnz || len(csr.ColIdx) != nnz || csr.RowPtr[m] != nnz {
+				t.Errorf("%v: unexpected number of stored elements: got %v, want %v", name, len(csr.Value), nnz)
+				continue
+			}
+			for i := 0; i < m; i++ {
+				for p := csr.RowPtr[i]; p < csr.RowPtr[i+1]; p++ {
+					j := csr.ColIdx[p]
+					if p > csr.RowPtr[i] && j <= csr.ColIdx[p-1] {
+						t.Errorf("%v: column indices of row %v not increasing", name, i)
+					}
+					if csr.Value[p] != aDense[i*n+j] {
+						t.Errorf("%v: unexpected element (%v,%v): got %v, want %v", name, i, j, csr.Value[p], aDense[i*n+j])
+					}
+				}
+			}
+
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				lenX, lenY := n, m
+				if tA != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {3, -2}} {
+					for _, ab := range [][2]float64{{0, 0.5}, {1, 0}, {-1.5, 2}, {0.5, 1}} {
+						alpha, beta := ab[0], ab[1]
+						prefix := fmt.Sprintf("%v,tA=%v,incX=%v,incY=%v,alpha=%v,beta=%v", name, tA, inc.x, inc.y, alpha, beta)
+						x := randvec(lenX, inc.x, rnd)
+						y := randvec(lenY, inc.y, rnd)
+
+						want := make([]float64, len(y))
+						copy(want, y)
+						impl.Dgbmv(tA, m, n, kL, kU, alpha, a, lda, x, inc.x, beta, want, inc.y)
+
+						got := make([]float64, len(y))
+						copy(got, y)
+						impl.DcsrMV(tA, alpha, csr, x, inc.x, beta, got, inc.y)
+
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%v: result differs from Dgbmv\nwant %v\ngot  %v", prefix, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDcsrMVPanics(t *testing.T) {
+	csr := impl.DgbToCSR(3, 3, 1, 1, []float64{0, 1, 2, 3, 4, 5, 6, 7, 0}, 3)
+	x := make([]float64, 3)
+	y := make([]float64, 3)
+	if panics(func() { impl.DcsrMV(blas.NoTrans, 1, csr, x, 1, 0, y, 1) }) {
+		t.Errorf("unexpected panic for valid arguments")
+	}
+	bad := csr
+	bad.RowPtr = bad.RowPtr[:2]
+	if !panics(func() { impl.DcsrMV(blas.NoTrans, 1, bad, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for short RowPtr")
+	}
+	bad = csr
+	bad.RowPtr = []int{0, 3, 2, 7}
+	if !panics(func() { impl.DcsrMV(blas.NoTrans, 1, bad, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for decreasing RowPtr")
+	}
+	for _, j := range []int{-1, 3} {
+		bad = csr
+		bad.ColIdx = append([]int(nil), csr.ColIdx...)
+		bad.ColIdx[1] = j
+		if !panics(func() { impl.DcsrMV(blas.NoTrans, 1, bad, x, 1, 0, y, 1) }) {
+			t.Errorf("expected panic for column index %d", j)
+		}
+	}
+	if !panics(func() { impl.DcsrMV(blas.NoTrans, 1, csr, x[:2], 1, 0, y, 1) }) {
+		t.Errorf("expected panic for short x")
+	}
+	if !panics(func() { impl.DgbToCSR(3, 3, 1, 1, make([]float64, 7), 3) }) {
+		t.Errorf("expected panic for short a")
+	}
+}
+
+func TestDgbToCSRFullBand(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {3, 7}, {8, 5}, {10, 10}} {
+		m, n := dims[0], dims[1]
+		for _, k := range [][2]int{{0, 0}, {1, 2}, {4, 1}} {
+			kL, kU := k[0], k[1]
+			lda := kL + kU + 1
+			a := randomBanded(rnd, m, n, kL, kU, lda)
+			csr := impl.DgbToCSR(m, n, kL, kU, a, lda)
+			if want := bandElements(m, n, kL, kU); len(csr.Value) != want {
+				t.Errorf("m=%v,n=%v,kL=%v,kU=%v: unexpected number of stored elements: got %v, want %v",
+					m, n, kL, kU, len(csr.Value), want)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// BandFormat specifies the storage layout of a general band matrix.
+type BandFormat byte
+
+const (
+	// NativeBand is the row-major band layout used by Dgbmv. Row i of the
+	// m×n band matrix A with kL sub-diagonals and kU super-diagonals is
+	// stored in a[i*lda:i*lda+kL+kU+1] with the diagonal element A[i][i] at
+	// a[i*lda+kL], so that
+	//  A[i][j] = a[i*lda+kL+j-i]  for max(0,i-kL) <= j <= min(n-1,i+kU).
+	NativeBand BandFormat = iota
+	// LAPACKBand is the column-major band layout used by LAPACK. Column j of
+	// A is stored in a[j*lda:j*lda+kL+kU+1] with the super-diagonals at the
+	// top and the diagonal element A[j][j] at a[j*lda+kU], so that
+	//  A[i][j] = a[j*lda+kU+i-j]  for max(0,j-kU) <= i <= min(m-1,j+kL).
+	// This is the layout of the array AB in the reference DGBMV, with lda
+	// corresponding to LDAB.
+	LAPACKBand
+)
+
+// DgbmvFormat performs one of the matrix-vector operations
+//  y = alpha * A * x + beta * y   if tA == blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA == blas.Trans or blas.ConjTrans
+// where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
+// stored in a using the layout f, x and y are vectors, and alpha and beta are
+// scalars. The requirements on the arguments are the same as for Dgbmv.
+//
+// A band matrix in the LAPACK layout is the native layout of its n×m
+// transpose with kU sub-diagonals and kL super-diagonals, so LAPACKBand input
+// is handled by calling Dgbmv on the transpose without copying a.
+func (impl Implementation) DgbmvFormat(f BandFormat, tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	switch f {
+	default:
+		panic(badBandFormat)
+	case NativeBand:
+		impl.Dgbmv(tA, m, n, kL, kU, alpha, a, lda, x, incX, beta, y, incY)
+	case LAPACKBand:
+		if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+			panic(badTranspose)
+		}
+		if m < 0 {
+			panic(mLT0)
+		}
+		if n < 0 {
+			panic(nLT0)
+		}
+		if kL < 0 {
+			panic(kLLT0)
+		}
+		if kU < 0 {
+			panic(kULT0)
+		}
+		tT := blas.Trans
+		if tA != blas.NoTrans {
+			tT = blas.NoTrans
+		}
+		impl.Dgbmv(tT, n, m, kU, kL, alpha, a, lda, x, incX, beta, y, incY)
+	}
+}
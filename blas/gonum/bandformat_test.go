@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// denseToLAPACKBand returns the LAPACK column-major band storage with leading
+// dimension ldab of the m×n dense band matrix a with leading dimension n. The
+// elements of the storage outside the matrix are set to pad.
+func denseToLAPACKBand(m, n, kL, kU int, a []float64, ldab int, pad float64) []float64 {
+	ab := make([]float64, n*ldab)
+	for i := range ab {
+		ab[i] = pad
+	}
+	for j := 0; j < n; j++ {
+		for i := max(0, j-kU); i < min(m, j+kL+1); i++ {
+			ab[j*ldab+kU+i-j] = a[i*n+j]
+		}
+	}
+	return ab
+}
+
+func TestDgbmvFormat(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, test := range []struct {
+			m, n, kL, kU int
+		}{
+			{0, 0, 0, 0},
+			{0, 3, 1, 1},
+			{3, 0, 1, 1},
+			{1, 1, 0, 0},
+			{4, 4, 0, 0},
+			{4, 4, 1, 1},
+			{5, 3, 2, 1},
+			{3, 5, 1, 2},
+			{6, 6, 3, 0},
+			{6, 6, 0, 3},
+			{7, 4, 5, 4},
+			{4, 7, 2, 6},
+		} {
+			m, n, kL, kU := test.m, test.n, test.kL, test.kU
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			a := randBandDense(rnd, m, n, kL, kU)
+			for _, extra := range []int{0, 2} {
+				ldab := kL + kU + 1 + extra
+				native := denseToBand(m, n, kL, kU, a, ldab, 0)
+				lapack := denseToLAPACKBand(m, n, kL, kU, a, ldab, math.NaN())
+				lapackCopy := make([]float64, len(lapack))
+				copy(lapackCopy, lapack)
+				for _, inc := range [][2]int{{1, 1}, {2, -3}, {-1, 2}} {
+					incX, incY := inc[0], inc[1]
+					x := randvec(lenX, incX, rnd)
+					y := randvec(lenY, incY, rnd)
+					for _, alpha := range []float64{0, 1, -2.5} {
+						for _, beta := range []float64{0, 0.5} {
+							want := make([]float64, len(y))
+							copy(want, y)
+							impl.DgbmvFormat(NativeBand, tA, m, n, kL, kU, alpha, native, ldab, x, incX, beta, want, incY)
+
+							got := make([]float64, len(y))
+							copy(got, y)
+							impl.DgbmvFormat(LAPACKBand, tA, m, n, kL, kU, alpha, lapack, ldab, x, incX, beta, got, incY)
+
+							if !floats.EqualApprox(got, want, tol) {
+								t.Errorf("tA=%v,m=%v,n=%v,kL=%v,kU=%v,ldab=%v,incX=%v,incY=%v,alpha=%v,beta=%v: LAPACK layout result differs from native layout\nwant %v\ngot  %v",
+									tA, m, n, kL, kU, ldab, incX, incY, alpha, beta, want, got)
+							}
+							if !floats.Same(lapack, lapackCopy) {
+								t.Errorf("tA=%v,m=%v,n=%v,kL=%v,kU=%v: unexpected modification of a", tA, m, n, kL, kU)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgbmvFormatPanics(t *testing.T) {
+	x := []float64{1, 2}
+	y := []float64{0, 0}
+	a := make([]float64, 6)
+	if !panics(func() { impl.DgbmvFormat(BandFormat(2), blas.NoTrans, 2, 2, 1, 1, 1, a, 3, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for illegal band format")
+	}
+	if !panics(func() { impl.DgbmvFormat(LAPACKBand, blas.NoTrans, -1, 2, 1, 1, 1, a, 3, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for m < 0")
+	}
+	if !panics(func() { impl.DgbmvFormat(LAPACKBand, blas.NoTrans, 2, 2, 1, 1, 1, a, 2, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for bad lda")
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// randvec returns a slice holding a vector of n random elements with
+// increment inc. Elements between the vector elements are also random.
+func randvec(n, inc int, rnd *rand.Rand) []float64 {
+	if n == 0 {
+		return nil
+	}
+	data := make([]float64, 1+(n-1)*abs(inc))
+	for i := range data {
+		data[i] = rnd.NormFloat64()
+	}
+	return data
+}
+
+// vecIndex returns the index into the slice of the i-th element of a vector
+// with n elements and increment inc.
+func vecIndex(i, n, inc int) int {
+	if inc < 0 {
+		return (i - n + 1) * inc
+	}
+	return i * inc
+}
+
+// The random matrix generators below return matrices with normally
+// distributed elements in row-major storage. Elements of the storage that
+// are not part of the matrix are set to NaN so that reads outside the
+// referenced region show up in the results. All randomness comes from rnd,
+// so a failing case is reproduced by the seed of its source.
+
+// randomDense returns an m×n dense matrix with leading dimension lda.
+func randomDense(rnd *rand.Rand, m, n, lda int) []float64 {
+	a := nanSlice(m * lda)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] = rnd.NormFloat64()
+		}
+	}
+	return a
+}
+
+// randomBanded returns an m×n band matrix with kL sub-diagonals and kU
+// super-diagonals in the band storage used by Dgbmv with leading dimension
+// lda.
+func randomBanded(rnd *rand.Rand, m, n, kL, kU, lda int) []float64 {
+	a := nanSlice(m * lda)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kL); j < min(n, i+kU+1); j++ {
+			a[i*lda+kL+j-i] = rnd.NormFloat64()
+		}
+	}
+	return a
+}
+
+// randomTriangular returns an n×n triangular matrix with leading dimension
+// lda stored in the triangle given by ul. The diagonal elements dominate
+// their rows, so the matrix is well-conditioned whether or not the diagonal
+// is treated as unit.
+func randomTriangular(rnd *rand.Rand, ul blas.Uplo, n, lda int) []float64 {
+	a := nanSlice(n * lda)
+	for i := 0; i < n; i++ {
+		jStart, jEnd := 0, i
+		if ul == blas.Upper {
+			jStart, jEnd = i+1, n
+		}
+		var sum float64
+		for j := jStart; j < jEnd; j++ {
+			v := rnd.NormFloat64() / float64(n)
+			a[i*lda+j] = v
+			sum += math.Abs(v)
+		}
+		a[i*lda+i] = randomDiag(rnd, sum)
+	}
+	return a
+}
+
+// randomTriangularBand returns an n×n triangular band matrix with k+1
+// diagonals in the storage used by Dtbsv with leading dimension lda. The
+// diagonal dominates its rows, and storage outside the band is NaN.
+func randomTriangularBand(rnd *rand.Rand, ul blas.Uplo, n, k, lda int) []float64 {
+	a := nanSlice(n * lda)
+	for i := 0; i < n; i++ {
+		var sum float64
+		for l := 1; l <= k; l++ {
+			if ul == blas.Upper && i+l < n {
+				v := rnd.NormFloat64() / float64(k+1)
+				a[i*lda+l] = v
+				sum += math.Abs(v)
+			}
+			if ul == blas.Lower && i-l >= 0 {
+				v := rnd.NormFloat64() / float64(k+1)
+				a[i*lda+k-l] = v
+				sum += math.Abs(v)
+			}
+		}
+		if ul == blas.Upper {
+			a[i*lda] = randomDiag(rnd, sum)
+		} else {
+			a[i*lda+k] = randomDiag(rnd, sum)
+		}
+	}
+	return a
+}
+
+// randomSymmetric returns an n×n symmetric matrix with leading dimension lda
+// stored in the triangle given by ul. The matrix is diagonally dominant and
+// so non-singular.
+func randomSymmetric(rnd *rand.Rand, ul blas.Uplo, n, lda int) []float64 {
+	a := nanSlice(n * lda)
+	offSum := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			v := rnd.NormFloat64() / float64(n)
+			if ul == blas.Upper {
+				a[i*lda+j] = v
+			} else {
+				a[j*lda+i] = v
+			}
+			offSum[i] += math.Abs(v)
+			offSum[j] += math.Abs(v)
+		}
+	}
+	for i, sum := range offSum {
+		a[i*lda+i] = randomDiag(rnd, sum)
+	}
+	return a
+}
+
+// randomDiag returns a diagonal element of random sign whose magnitude
+// exceeds sum by between 1 and 2.
+func randomDiag(rnd *rand.Rand, sum float64) float64 {
+	d := sum + 1 + rnd.Float64()
+	if rnd.Intn(2) == 0 {
+		d = -d
+	}
+	return d
+}
+
+// nanSlice returns a slice of length n filled with NaN.
+func nanSlice(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = math.NaN()
+	}
+	return s
+}
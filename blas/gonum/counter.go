@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "sync/atomic"
+
+// MulAddCounter counts the floating-point multiply-adds performed by the
+// matrix-vector products of the Level 2 routines Dgemv, Dgbmv, Dger, Dsymv and
+// Dsbmv, and their single precision equivalents. The scaling of y by beta is
+// not counted. A MulAddCounter is safe for concurrent use.
+//
+// Counting is enabled by setting the Counter field of an Implementation.
+type MulAddCounter struct {
+	n uint64
+}
+
+// MulAdds returns the number of multiply-adds counted since the counter was
+// created or last reset.
+func (c *MulAddCounter) MulAdds() uint64 {
+	return atomic.LoadUint64(&c.n)
+}
+
+// Reset sets the count to zero.
+func (c *MulAddCounter) Reset() {
+	atomic.StoreUint64(&c.n, 0)
+}
+
+// add adds n to the count if c is not nil.
+func (c *MulAddCounter) add(n int) {
+	if c == nil {
+		return
+	}
+	atomic.AddUint64(&c.n, uint64(n))
+}
+
+// bandElements returns the number of elements within the band of an m×n
+// band matrix with kL sub-diagonals and kU super-diagonals.
+func bandElements(m, n, kL, kU int) int {
+	var count int
+	for i := 0; i < min(m, n+kL); i++ {
+		count += min(n, i+kU+1) - max(0, i-kL)
+	}
+	return count
+}
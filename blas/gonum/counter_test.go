@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestMulAddCounter(t *testing.T) {
+	var c MulAddCounter
+	impl := Implementation{Counter: &c}
+
+	for _, test := range []struct {
+		m, n int
+		want uint64
+	}{
+		{m: 1, n: 1, want: 1},
+		{m: 3, n: 5, want: 15},
+		{m: 10, n: 2, want: 20},
+	} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			lenX, lenY := test.n, test.m
+			if tA != blas.NoTrans {
+				lenX, lenY = test.m, test.n
+			}
+			a := make([]float64, test.m*test.n)
+			x := make([]float64, lenX)
+			y := make([]float64, lenY)
+
+			c.Reset()
+			impl.Dgemv(tA, test.m, test.n, 1, a, test.n, x, 1, 0, y, 1)
+			if got := c.MulAdds(); got != test.want {
+				t.Errorf("Dgemv tA=%v,m=%v,n=%v: unexpected count: got %v, want %v", tA, test.m, test.n, got, test.want)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		m, n, kL, kU int
+		want         uint64
+	}{
+		// Diagonal matrix.
+		{m: 5, n: 5, kL: 0, kU: 0, want: 5},
+		// Tridiagonal matrix.
+		{m: 5, n: 5, kL: 1, kU: 1, want: 13},
+		// Upper bidiagonal wide matrix with a truncated last row.
+		{m: 3, n: 6, kL: 0, kU: 1, want: 6},
+		// Tall matrix with rows below the band.
+		{m: 6, n: 3, kL: 1, kU: 0, want: 6},
+		// Band wider than the matrix is equivalent to a dense matrix.
+		{m: 4, n: 3, kL: 5, kU: 5, want: 12},
+		// O(nk) work for a large narrow band.
+		{m: 1000, n: 1000, kL: 2, kU: 3, want: 1000*6 - 3 - 6},
+	} {
+		lda := test.kL + test.kU + 1
+		a := make([]float64, test.m*lda)
+		x := make([]float64, test.n)
+		y := make([]float64, test.m)
+
+		c.Reset()
+		impl.Dgbmv(blas.NoTrans, test.m, test.n, test.kL, test.kU, 1, a, lda, x, 1, 0, y, 1)
+		prefix := fmt.Sprintf("Dgbmv m=%v,n=%v,kL=%v,kU=%v", test.m, test.n, test.kL, test.kU)
+		if got := c.MulAdds(); got != test.want {
+			t.Errorf("%v: unexpected count: got %v, want %v", prefix, got, test.want)
+		}
+	}
+
+	// Calls that perform no multiply-adds are not counted, and counts
+	// accumulate across calls.
+	c.Reset()
+	a := make([]float64, 9)
+	x := make([]float64, 3)
+	y := make([]float64, 3)
+	impl.Dgemv(blas.NoTrans, 3, 3, 0, a, 3, x, 1, 2, y, 1)
+	impl.Dsymv(blas.Upper, 3, 1, a, 3, x, 1, 0, y, 1)
+	impl.Dger(3, 3, 1, x, 1, y, 1, a, 3)
+	if got, want := c.MulAdds(), uint64(18); got != want {
+		t.Errorf("unexpected accumulated count: got %v, want %v", got, want)
+	}
+}
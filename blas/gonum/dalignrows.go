@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "reflect"
+
+// rowAlign is the alignment in bytes of the rows returned by DalignRows,
+// the size of a cache line on common hardware.
+const rowAlign = 64
+
+// DalignRows returns a copy of the m×n dense matrix A, stored in a with
+// leading dimension lda, and the leading dimension of the copy. The leading
+// dimension is n rounded up to a multiple of 8 elements and the elements
+// between the end of a row and the start of the next are zero.
+//
+// The copy is placed so that every row starts at an address that is a
+// multiple of 64 bytes when DalignRows returns. The alignment is best-effort:
+// it relies on the garbage collector not moving heap objects, which the Go
+// specification does not guarantee, so callers must not depend on it for
+// correctness.
+//
+// The copy can be passed to Dgemv and the other routines taking a dense
+// matrix in place of a, with the same results.
+func (Implementation) DalignRows(m, n int, a []float64, lda int) (aligned []float64, ldAligned int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+
+	const perLine = rowAlign / 8
+	ldAligned = max(perLine, (n+perLine-1)/perLine*perLine)
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return nil, ldAligned
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// Allocate room for one extra line and start the copy at the first
+	// aligned element. The current garbage collector does not move heap
+	// objects, so in practice the alignment is kept for the lifetime of the
+	// slice.
+	buf := make([]float64, m*ldAligned+perLine-1)
+	off := 0
+	if r := reflect.ValueOf(buf).Pointer() % rowAlign; r != 0 {
+		off = int((rowAlign - r) / 8)
+	}
+	aligned = buf[off : off+m*ldAligned : off+m*ldAligned]
+	for i := 0; i < m; i++ {
+		copy(aligned[i*ldAligned:i*ldAligned+n], a[i*lda:i*lda+n])
+	}
+	return aligned, ldAligned
+}
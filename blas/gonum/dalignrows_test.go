@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDalignRows(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {3, 7}, {4, 8}, {5, 9}, {16, 17}, {7, 33}} {
+		m, n := dims[0], dims[1]
+		for _, lda := range []int{n, n + 1, n + 5} {
+			name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+			a := randomDense(rnd, m, n, lda)
+
+			aligned, ldAligned := impl.DalignRows(m, n, a, lda)
+			if ldAligned < n || ldAligned%8 != 0 {
+				t.Errorf("%v: unexpected leading dimension %v", name, ldAligned)
+				continue
+			}
+			if len(aligned) != m*ldAligned {
+				t.Errorf("%v: unexpected length %v, want %v", name, len(aligned), m*ldAligned)
+				continue
+			}
+			// The alignment is best-effort, but holds with the current
+			// non-moving garbage collector.
+			base := reflect.ValueOf(aligned).Pointer()
+			for i := 0; i < m; i++ {
+				if addr := base + uintptr(8*i*ldAligned); addr%64 != 0 {
+					t.Errorf("%v: row %v not aligned: address %#x", name, i, addr)
+				}
+				for j := n; j < ldAligned; j++ {
+					if aligned[i*ldAligned+j] != 0 {
+						t.Errorf("%v: non-zero padding at (%v,%v)", name, i, j)
+					}
+				}
+			}
+
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				lenX, lenY := n, m
+				if tA != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				x := randvec(lenX, 1, rnd)
+				y := randvec(lenY, 1, rnd)
+				want := make([]float64, len(y))
+				copy(want, y)
+				impl.Dgemv(tA, m, n, 1.5, a, lda, x, 1, 0.5, want, 1)
+				got := make([]float64, len(y))
+				copy(got, y)
+				impl.Dgemv(tA, m, n, 1.5, aligned, ldAligned, x, 1, 0.5, got, 1)
+				if !floats.Same(got, want) {
+					t.Errorf("%v,tA=%v: Dgemv result differs\nwant %v\ngot  %v", name, tA, want, got)
+				}
+			}
+		}
+	}
+}
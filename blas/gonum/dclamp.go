@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// DscalClamp scales x by alpha and clamps the result to [lo, hi].
+//  x[i] = min(max(alpha * x[i], lo), hi)
+// Unlike Dscal, DscalClamp accepts a negative incX, in which case the
+// elements of x are traversed in reverse order. The products are the ones
+// computed by Dscal, so elements whose product lies in [lo, hi] are not
+// affected by the clamping. NaN results are left as NaN.
+//
+// DscalClamp will panic if lo > hi or if either is NaN.
+func (impl Implementation) DscalClamp(n int, alpha float64, x []float64, incX int, lo, hi float64) {
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if !(lo <= hi) {
+		panic(badClampRange)
+	}
+	if n < 1 {
+		if n == 0 {
+			return
+		}
+		panic(nLT0)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	// Scaling is independent of the traversal order.
+	if incX < 0 {
+		incX = -incX
+	}
+	impl.Dscal(n, alpha, x, incX)
+	clampInc(n, x, incX, lo, hi)
+}
+
+// DaxpyClamp adds alpha times x to y and clamps the result to [lo, hi].
+//  y[i] = min(max(alpha * x[i] + y[i], lo), hi)
+// The sums are the ones computed by Daxpy, so elements of y whose sum lies in
+// [lo, hi] are not affected by the clamping. Elements of y outside [lo, hi]
+// on entry are clamped even when alpha is zero. NaN results are left as NaN.
+//
+// DaxpyClamp will panic if lo > hi or if either is NaN.
+func (impl Implementation) DaxpyClamp(n int, alpha float64, x []float64, incX int, y []float64, incY int, lo, hi float64) {
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+	if !(lo <= hi) {
+		panic(badClampRange)
+	}
+	if n < 1 {
+		if n == 0 {
+			return
+		}
+		panic(nLT0)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	impl.Daxpy(n, alpha, x, incX, y, incY)
+	if incY < 0 {
+		incY = -incY
+	}
+	clampInc(n, y, incY, lo, hi)
+}
+
+// clampInc clamps the n elements of x with positive increment incX to
+// [lo, hi], leaving NaN elements unchanged.
+func clampInc(n int, x []float64, incX int, lo, hi float64) {
+	for ix := 0; ix < n*incX; ix += incX {
+		switch v := x[ix]; {
+		case v < lo:
+			x[ix] = lo
+		case v > hi:
+			x[ix] = hi
+		}
+	}
+}
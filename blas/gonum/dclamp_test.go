@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDscalClamp(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 33} {
+		for _, incX := range []int{1, 2, -1, -3} {
+			for _, alpha := range []float64{0, 1, -2.5, 8} {
+				for _, r := range [][2]float64{{-1, 1}, {0, 3}, {-0.5, -0.5}, {math.Inf(-1), math.Inf(1)}} {
+					lo, hi := r[0], r[1]
+					name := fmt.Sprintf("n=%v,incX=%v,alpha=%v,lo=%v,hi=%v", n, incX, alpha, lo, hi)
+					x := randvec(n, incX, rnd)
+					if n > 2 {
+						x[0] = math.NaN()
+					}
+					absInc := incX
+					if absInc < 0 {
+						absInc = -absInc
+					}
+					// The products computed by Dscal.
+					want := make([]float64, len(x))
+					copy(want, x)
+					impl.Dscal(n, alpha, want, absInc)
+
+					got := make([]float64, len(x))
+					copy(got, x)
+					impl.DscalClamp(n, alpha, got, incX, lo, hi)
+					checkClamp(t, name, n, absInc, x, want, got, lo, hi)
+				}
+			}
+		}
+	}
+}
+
+func TestDaxpyClamp(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 33} {
+		for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 1}, {1, -2}, {-3, -1}} {
+			for _, alpha := range []float64{0, 1, -2.5, 8} {
+				for _, r := range [][2]float64{{-1, 1}, {0, 3}, {-0.5, -0.5}, {math.Inf(-1), math.Inf(1)}} {
+					lo, hi := r[0], r[1]
+					name := fmt.Sprintf("n=%v,incX=%v,incY=%v,alpha=%v,lo=%v,hi=%v", n, inc.x, inc.y, alpha, lo, hi)
+					x := randvec(n, inc.x, rnd)
+					y := randvec(n, inc.y, rnd)
+					absIncY := inc.y
+					if absIncY < 0 {
+						absIncY = -absIncY
+					}
+					if n > 2 {
+						y[absIncY] = math.NaN()
+					}
+					// The sums computed by Daxpy.
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Daxpy(n, alpha, x, inc.x, want, inc.y)
+
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DaxpyClamp(n, alpha, x, inc.x, got, inc.y, lo, hi)
+					checkClamp(t, name, n, absIncY, y, want, got, lo, hi)
+				}
+			}
+		}
+	}
+}
+
+// checkClamp checks that got is want with its elements clamped to [lo, hi]
+// and that the elements of got outside the vector are those of orig.
+func checkClamp(t *testing.T, name string, n, inc int, orig, want, got []float64, lo, hi float64) {
+	t.Helper()
+	for i := range got {
+		if i%inc != 0 || i/inc >= n {
+			if got[i] != orig[i] && !(math.IsNaN(got[i]) && math.IsNaN(orig[i])) {
+				t.Errorf("%v: element %v outside the vector modified", name, i)
+			}
+			continue
+		}
+		w := want[i]
+		switch {
+		case math.IsNaN(w):
+			if !math.IsNaN(got[i]) {
+				t.Errorf("%v: unexpected element %v: got %v, want NaN", name, i, got[i])
+			}
+		case w < lo:
+			if got[i] != lo {
+				t.Errorf("%v: element %v not clamped to lo: got %v, want %v", name, i, got[i], lo)
+			}
+		case w > hi:
+			if got[i] != hi {
+				t.Errorf("%v: element %v not clamped to hi: got %v, want %v", name, i, got[i], hi)
+			}
+		default:
+			if got[i] != w {
+				t.Errorf("%v: in-range element %v changed: got %v, want %v", name, i, got[i], w)
+			}
+		}
+	}
+}
+
+func TestDclampPanics(t *testing.T) {
+	x := make([]float64, 3)
+	y := make([]float64, 3)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"DscalClamp lo > hi", func() { impl.DscalClamp(3, 1, x, 1, 1, -1) }},
+		{"DscalClamp NaN lo", func() { impl.DscalClamp(3, 1, x, 1, math.NaN(), 1) }},
+		{"DscalClamp zero incX", func() { impl.DscalClamp(3, 1, x, 0, -1, 1) }},
+		{"DscalClamp short x", func() { impl.DscalClamp(3, 1, x, -2, -1, 1) }},
+		{"DaxpyClamp NaN hi", func() { impl.DaxpyClamp(3, 1, x, 1, y, 1, -1, math.NaN()) }},
+		{"DaxpyClamp short y", func() { impl.DaxpyClamp(3, 1, x, 1, y[:2], 1, -1, 1) }},
+		{"DaxpyClamp n < 0", func() { impl.DaxpyClamp(-1, 1, x, 1, y, 1, -1, 1) }},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%v: expected panic", test.name)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DcovarianceUpper computes the upper triangle of the sample covariance matrix
+//  C = 1/(m-1) * Xcᵀ * Xc
+// where X is an m×n dense matrix of m observations of n variables, Xc is X
+// with the mean of each column subtracted from that column, and C is an n×n
+// symmetric matrix. The strictly lower triangle of C is not referenced.
+//
+// The column means are computed first and Xc is formed in a temporary m×n
+// matrix, so x is not modified. C is then formed by Dsyrk. If m is less than
+// 2 the covariance is undefined and the upper triangle of C is set to NaN.
+func (impl Implementation) DcovarianceUpper(m, n int, x []float64, ldx int, c []float64, ldc int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if ldx < max(1, n) {
+		panic(badLdX)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if m > 0 && len(x) < ldx*(m-1)+n {
+		panic(shortX)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+
+	if m < 2 {
+		nan := math.NaN()
+		for i := 0; i < n; i++ {
+			ctmp := c[i*ldc+i : i*ldc+n]
+			for j := range ctmp {
+				ctmp[j] = nan
+			}
+		}
+		return
+	}
+
+	// Form the column means of X.
+	mean := make([]float64, n)
+	for i := 0; i < m; i++ {
+		for j, v := range x[i*ldx : i*ldx+n] {
+			mean[j] += v
+		}
+	}
+	for j := range mean {
+		mean[j] /= float64(m)
+	}
+
+	// Form Xc = X - 1 * meanᵀ.
+	xc := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		xctmp := xc[i*n : i*n+n]
+		for j, v := range x[i*ldx : i*ldx+n] {
+			xctmp[j] = v - mean[j]
+		}
+	}
+
+	impl.Dsyrk(blas.Upper, blas.Trans, n, m, 1/float64(m-1), xc, n, 0, c, ldc)
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDcovarianceUpper(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range []struct{ m, n int }{{2, 1}, {3, 3}, {10, 4}, {5, 12}, {40, 7}} {
+		// An offset makes the column means large compared to the spread,
+		// which the centering must remove.
+		for _, offset := range []float64{0, 100} {
+			m, n := mn.m, mn.n
+			name := fmt.Sprintf("m=%d,n=%d,offset=%v", m, n, offset)
+			ldx := n + 2
+			x := randomDense(rnd, m, n, ldx)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					x[i*ldx+j] += offset * float64(j+1)
+				}
+			}
+			xCopy := make([]float64, len(x))
+			copy(xCopy, x)
+
+			// Center the columns explicitly and form the dense Xcᵀ * Xc.
+			xc := make([]float64, m*n)
+			for j := 0; j < n; j++ {
+				var mean float64
+				for i := 0; i < m; i++ {
+					mean += x[i*ldx+j]
+				}
+				mean /= float64(m)
+				for i := 0; i < m; i++ {
+					xc[i*n+j] = x[i*ldx+j] - mean
+				}
+			}
+			want := make([]float64, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					var sum float64
+					for l := 0; l < m; l++ {
+						sum += xc[l*n+i] * xc[l*n+j]
+					}
+					want[i*n+j] = sum / float64(m-1)
+				}
+			}
+
+			ldc := n + 3
+			c := nanSlice(ldc*(n-1) + n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < i; j++ {
+					c[i*ldc+j] = -1
+				}
+			}
+			impl.DcovarianceUpper(m, n, x, ldx, c, ldc)
+			if !floats.Same(x, xCopy) {
+				t.Errorf("%s: x modified", name)
+			}
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					got := c[i*ldc+j]
+					if j < i {
+						if got != -1 {
+							t.Errorf("%s: lower triangle modified at (%d,%d)", name, i, j)
+						}
+						continue
+					}
+					if !floats.EqualWithinAbsOrRel(got, want[i*n+j], tol, tol) {
+						t.Errorf("%s: unexpected C[%d,%d]: got %v, want %v", name, i, j, got, want[i*n+j])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDcovarianceUpperSingleObservation(t *testing.T) {
+	for _, m := range []int{0, 1} {
+		c := []float64{1, 2, 3, 4}
+		impl.DcovarianceUpper(m, 2, []float64{5, 6}, 2, c, 2)
+		if !math.IsNaN(c[0]) || !math.IsNaN(c[1]) || c[2] != 3 || !math.IsNaN(c[3]) {
+			t.Errorf("m=%d: unexpected C: got %v, want upper triangle NaN", m, c)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// DgbmvBandResult computes the banded matrix-matrix product
+//  C = alpha * A * B + beta * C
+// where A is an m×n band matrix with kLA sub-diagonals and kUA
+// super-diagonals, B is an n×p band matrix with kLB sub-diagonals and kUB
+// super-diagonals, and alpha and beta are scalars. The m×p result C has
+// kLA+kLB sub-diagonals and kUA+kUB super-diagonals and is stored in c in
+// band format with leading dimension ldc, which must be at least
+// kLA+kLB+kUA+kUB+1. All three matrices use the band layout of Dgbmv.
+//
+// Each column of B is a vector with a band of non-zero elements, so C is
+// the result of applying A to these vectors, with the product restricted to
+// the band where it can be non-zero. DgbmvBandResult is a first step towards
+// a banded Level 3 matrix product.
+func (Implementation) DgbmvBandResult(m, n, p, kLA, kUA int, alpha float64, a []float64, lda int, kLB, kUB int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if p < 0 {
+		panic(pLT0)
+	}
+	if kLA < 0 || kLB < 0 {
+		panic(kLLT0)
+	}
+	if kUA < 0 || kUB < 0 {
+		panic(kULT0)
+	}
+	if lda < kLA+kUA+1 {
+		panic(badLdA)
+	}
+	if ldb < kLB+kUB+1 {
+		panic(badLdB)
+	}
+	kLC := kLA + kLB
+	kUC := kUA + kUB
+	if ldc < kLC+kUC+1 {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || p == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if n > 0 {
+		if len(a) < lda*(min(m, n+kLA)-1)+kLA+kUA+1 {
+			panic(shortA)
+		}
+		if len(b) < ldb*(min(n, p+kLB)-1)+kLB+kUB+1 {
+			panic(shortB)
+		}
+	}
+	if len(c) < ldc*(min(m, p+kLC)-1)+kLC+kUC+1 {
+		panic(shortC)
+	}
+
+	// Quick return if possible.
+	if (alpha == 0 || n == 0) && beta == 1 {
+		return
+	}
+
+	for i := 0; i < min(m, p+kLC); i++ {
+		for j := max(0, i-kLC); j < min(p, i+kUC+1); j++ {
+			var sum float64
+			if alpha != 0 {
+				// A[i][l] is non-zero for i-kLA ≤ l ≤ i+kUA and
+				// B[l][j] for j-kUB ≤ l ≤ j+kLB.
+				lo := max(0, max(i-kLA, j-kUB))
+				hi := min(n, min(i+kUA, j+kLB)+1)
+				for l := lo; l < hi; l++ {
+					sum += a[i*lda+kLA+l-i] * b[l*ldb+kLB+j-l]
+				}
+			}
+			ic := i*ldc + kLC + j - i
+			if beta == 0 {
+				c[ic] = alpha * sum
+			} else {
+				c[ic] = alpha*sum + beta*c[ic]
+			}
+		}
+	}
+}
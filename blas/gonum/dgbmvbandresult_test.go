@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgbmvBandResult(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][3]int{{1, 1, 1}, {1, 3, 2}, {4, 1, 3}, {5, 5, 5}, {6, 4, 7}, {7, 8, 3}} {
+		m, n, p := dims[0], dims[1], dims[2]
+		for _, kA := range [][2]int{{0, 0}, {1, 1}, {2, 0}, {0, 3}, {3, 2}} {
+			for _, kB := range [][2]int{{0, 0}, {1, 2}, {2, 1}, {4, 0}} {
+				for _, ab := range [][2]float64{{0, 0.5}, {1, 0}, {-1.5, 2}} {
+					kLA, kUA := kA[0], kA[1]
+					kLB, kUB := kB[0], kB[1]
+					kLC, kUC := kLA+kLB, kUA+kUB
+					alpha, beta := ab[0], ab[1]
+					lda := kLA + kUA + 1
+					ldb := kLB + kUB + 2
+					ldc := kLC + kUC + 1
+
+					aDense := randBandDense(rnd, m, n, kLA, kUA)
+					bDense := randBandDense(rnd, n, p, kLB, kUB)
+					cDense := randBandDense(rnd, m, p, kLC, kUC)
+					a := denseToBand(m, n, kLA, kUA, aDense, lda, math.NaN())
+					b := denseToBand(n, p, kLB, kUB, bDense, ldb, math.NaN())
+					c := denseToBand(m, p, kLC, kUC, cDense, ldc, math.NaN())
+
+					impl.Dgemm(blas.NoTrans, blas.NoTrans, m, p, n, alpha, aDense, n, bDense, p, beta, cDense, p)
+					impl.DgbmvBandResult(m, n, p, kLA, kUA, alpha, a, lda, kLB, kUB, b, ldb, beta, c, ldc)
+
+					name := fmt.Sprintf("m=%v,n=%v,p=%v,kLA=%v,kUA=%v,kLB=%v,kUB=%v,alpha=%v,beta=%v", m, n, p, kLA, kUA, kLB, kUB, alpha, beta)
+					for i := 0; i < m; i++ {
+						for j := 0; j < p; j++ {
+							want := cDense[i*p+j]
+							if j < i-kLC || j > i+kUC {
+								if want != 0 {
+									t.Errorf("%v: dense product non-zero outside the result band at (%v,%v)", name, i, j)
+								}
+								continue
+							}
+							got := c[i*ldc+kLC+j-i]
+							if math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+								t.Errorf("%v: unexpected element (%v,%v): want %v, got %v", name, i, j, want, got)
+							}
+						}
+					}
+					// Elements of the band storage outside C must not
+					// be referenced.
+					for i := 0; i < len(c)/ldc; i++ {
+						for jb := 0; jb < ldc; jb++ {
+							j := i - kLC + jb
+							if (i >= m || j < 0 || j >= p) && !math.IsNaN(c[i*ldc+jb]) {
+								t.Errorf("%v: band padding modified at row %v, column %v", name, i, jb)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// randBandDense returns a random m×n dense matrix with leading dimension n
+// that is zero outside the band with kL sub-diagonals and kU super-diagonals.
+func randBandDense(rnd *rand.Rand, m, n, kL, kU int) []float64 {
+	a := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kL); j < min(n, i+kU+1); j++ {
+			a[i*n+j] = rnd.NormFloat64()
+		}
+	}
+	return a
+}
+
+// denseToBand returns the band storage with leading dimension ldab of the
+// m×n dense band matrix a with leading dimension n. The elements of the
+// storage outside the matrix are set to pad.
+func denseToBand(m, n, kL, kU int, a []float64, ldab int, pad float64) []float64 {
+	ab := make([]float64, m*ldab)
+	for i := range ab {
+		ab[i] = pad
+	}
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kL); j < min(n, i+kU+1); j++ {
+			ab[i*ldab+kL+j-i] = a[i*n+j]
+		}
+	}
+	return ab
+}
@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemmAccum(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	transposes := []blas.Transpose{blas.NoTrans, blas.Trans}
+	for _, test := range []struct {
+		m, n   int
+		splits []int // Boundaries of the terms along the inner dimension.
+	}{
+		{m: 1, n: 1, splits: []int{0, 1}},
+		{m: 3, n: 4, splits: []int{0, 2, 5}},
+		{m: 5, n: 2, splits: []int{0, 0, 3, 4, 7}},
+		{m: blockSize + 3, n: 2*blockSize + 1, splits: []int{0, 1, blockSize + 2}},
+	} {
+		m, n := test.m, test.n
+		k := test.splits[len(test.splits)-1]
+		for _, tA := range transposes {
+			for _, tB := range transposes {
+				name := fmt.Sprintf("m=%d,n=%d,splits=%v,tA=%c,tB=%c", m, n, test.splits, tA, tB)
+				aRows, aCols := m, k
+				if tA != blas.NoTrans {
+					aRows, aCols = k, m
+				}
+				bRows, bCols := k, n
+				if tB != blas.NoTrans {
+					bRows, bCols = n, k
+				}
+				lda := max(1, aCols) + 2
+				ldb := max(1, bCols) + 3
+				ldc := n + 1
+				a := randomDense(rnd, aRows, aCols, lda)
+				b := randomDense(rnd, bRows, bCols, ldb)
+				c := randomDense(rnd, m, n, ldc)
+
+				// Form the terms alpha_t * op(A_t) * op(B_t) as blocks of the
+				// inner dimension, and the equivalent single product where
+				// the blocks of op(A) are scaled by the alphas.
+				got := make([]float64, len(c))
+				copy(got, c)
+				aScaled := make([]float64, len(a))
+				copy(aScaled, a)
+				for i := 0; i+1 < len(test.splits); i++ {
+					k0, k1 := test.splits[i], test.splits[i+1]
+					alpha := float64(i) - 0.5
+					aOff, bOff := k0, k0*ldb
+					if tA != blas.NoTrans {
+						aOff = k0 * lda
+					}
+					if tB != blas.NoTrans {
+						bOff = k0
+					}
+					impl.DgemmAccum(tA, tB, m, n, k1-k0, alpha, a[aOff:], lda, b[bOff:], ldb, got, ldc)
+					for l := k0; l < k1; l++ {
+						for r := 0; r < m; r++ {
+							if tA == blas.NoTrans {
+								aScaled[r*lda+l] *= alpha
+							} else {
+								aScaled[l*lda+r] *= alpha
+							}
+						}
+					}
+				}
+				want := make([]float64, len(c))
+				copy(want, c)
+				impl.Dgemm(tA, tB, m, n, k, 1, aScaled, lda, b, ldb, 1, want, ldc)
+
+				for i := range c {
+					if i%ldc < n {
+						if !floats.EqualWithinAbsOrRel(got[i], want[i], tol, tol) {
+							t.Errorf("%s: unexpected C[%d,%d]: got %v, want %v", name, i/ldc, i%ldc, got[i], want[i])
+						}
+					} else if !floats.Same(got[i:i+1], c[i:i+1]) {
+						t.Errorf("%s: element outside C modified", name)
+					}
+				}
+
+				// A single call must match Dgemm with beta == 1 exactly.
+				got = make([]float64, len(c))
+				copy(got, c)
+				impl.DgemmAccum(tA, tB, m, n, k, 1.5, a, lda, b, ldb, got, ldc)
+				copy(want, c)
+				impl.Dgemm(tA, tB, m, n, k, 1.5, a, lda, b, ldb, 1, want, ldc)
+				if !floats.Same(got, want) {
+					t.Errorf("%s: DgemmAccum differs from Dgemm with beta == 1", name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvActivate computes
+//  y[i] = f((A * x)[i] + bias[i])   if tA = blas.NoTrans
+//  y[i] = f((Aᵀ * x)[i] + bias[i])  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x and y are vectors, bias is a vector with
+// unit increment and f is an elementwise function such as the activation of a
+// neural network layer. If f is nil it is taken to be the identity. The input
+// values of y are not used.
+//
+// If tA == blas.NoTrans, each element of y is the dot product of a row of A
+// with x, and the bias and f are applied as soon as it is formed, so y is
+// written once. Otherwise the elements of y accumulate over all rows of A, so
+// y is initialized with bias and f is applied in a final pass.
+//
+// If A has no columns in the product, y[i] = f(bias[i]).
+func (impl Implementation) DgemvActivate(tA blas.Transpose, m, n int, a []float64, lda int, x []float64, incX int, bias []float64, y []float64, incY int, f func(float64) float64) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+
+	// Quick return if possible.
+	if lenY == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(bias) < lenY {
+		panic(shortBias)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if lenX > 0 {
+		if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+			panic(shortX)
+		}
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	if tA == blas.NoTrans {
+		iy := ky
+		for i := 0; i < m; i++ {
+			var dot float64
+			if incX == 1 {
+				dot = f64.DotUnitary(a[i*lda:i*lda+n], x[:n])
+			} else if n > 0 {
+				dot = f64.DotInc(x, a[i*lda:i*lda+n], uintptr(n), uintptr(incX), 1, uintptr(kx), 0)
+			}
+			v := dot + bias[i]
+			if f != nil {
+				v = f(v)
+			}
+			y[iy] = v
+			iy += incY
+		}
+		return
+	}
+
+	impl.Dcopy(n, bias, 1, y, incY)
+	ix := kx
+	for i := 0; i < m; i++ {
+		f64.AxpyInc(x[ix], a[i*lda:i*lda+n], y, uintptr(n), 1, uintptr(incY), 0, uintptr(ky))
+		ix += incX
+	}
+	if f == nil {
+		return
+	}
+	iy := ky
+	for j := 0; j < n; j++ {
+		y[iy] = f(y[iy])
+		iy += incY
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvActivate(t *testing.T) {
+	const tol = 1e-14
+
+	relu := func(v float64) float64 { return math.Max(v, 0) }
+	rnd := rand.New(rand.NewSource(1))
+	for _, act := range []struct {
+		name string
+		f    func(float64) float64
+	}{
+		{"identity", nil},
+		{"relu", relu},
+		{"tanh", math.Tanh},
+	} {
+		for _, dims := range [][2]int{{1, 1}, {1, 4}, {5, 1}, {6, 6}, {9, 13}, {0, 3}, {3, 0}} {
+			m, n := dims[0], dims[1]
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				lenX, lenY := n, m
+				if tA != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 1}, {1, -2}} {
+					name := fmt.Sprintf("f=%v,m=%v,n=%v,tA=%v,incX=%v,incY=%v", act.name, m, n, tA, inc.x, inc.y)
+					lda := max(1, n+1)
+					a := randomDense(rnd, m, n, lda)
+					x := randvec(lenX, inc.x, rnd)
+					bias := randvec(lenY, 1, rnd)
+					y := randvec(lenY, inc.y, rnd)
+					// The input values of y must not be used.
+					for i := 0; i < lenY; i++ {
+						y[vecIndex(i, lenY, inc.y)] = math.NaN()
+					}
+
+					// Compute the result in three separate steps.
+					want := make([]float64, len(y))
+					copy(want, y)
+					if lenY > 0 {
+						Implementation{}.Dcopy(lenY, bias, 1, want, inc.y)
+						if m > 0 && n > 0 {
+							impl.Dgemv(tA, m, n, 1, a, lda, x, inc.x, 1, want, inc.y)
+						}
+						if act.f != nil {
+							for i := 0; i < lenY; i++ {
+								iy := vecIndex(i, lenY, inc.y)
+								want[iy] = act.f(want[iy])
+							}
+						}
+					}
+
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DgemvActivate(tA, m, n, a, lda, x, inc.x, bias, got, inc.y, act.f)
+
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvActivatePanics(t *testing.T) {
+	a := make([]float64, 6)
+	x := make([]float64, 3)
+	bias := make([]float64, 2)
+	y := make([]float64, 2)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"bad transpose", func() { impl.DgemvActivate('X', 2, 3, a, 3, x, 1, bias, y, 1, nil) }},
+		{"bad lda", func() { impl.DgemvActivate(blas.NoTrans, 2, 3, a, 2, x, 1, bias, y, 1, nil) }},
+		{"zero incY", func() { impl.DgemvActivate(blas.NoTrans, 2, 3, a, 3, x, 1, bias, y, 0, nil) }},
+		{"short bias", func() { impl.DgemvActivate(blas.NoTrans, 2, 3, a, 3, x, 1, bias[:1], y, 1, nil) }},
+		{"short x", func() { impl.DgemvActivate(blas.NoTrans, 2, 3, a, 3, x[:2], 1, bias, y, 1, nil) }},
+		{"short y", func() { impl.DgemvActivate(blas.NoTrans, 2, 3, a, 3, x, 1, bias, y[:1], 1, nil) }},
+		{"short a", func() { impl.DgemvActivate(blas.Trans, 2, 3, a[:5], 3, bias, 1, x, y, 1, nil) }},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%v: expected panic", test.name)
+		}
+	}
+}
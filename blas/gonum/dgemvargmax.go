@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvArgmax computes the elements of
+//  z = A * x   if tA = blas.NoTrans
+//  z = Aᵀ * x  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix and x is a vector, and returns the index and
+// the value of the largest element of z. If several elements have the largest
+// value, the lowest index is returned. NaN elements are ignored as in
+// floats.MaxIdx, and if all elements are NaN, argmax is 0 and maxVal is NaN.
+// If z has no elements, argmax is -1 and maxVal is NaN.
+//
+// Each element of z is computed as a dot product and compared with the
+// running maximum, so z is never stored. For tA = blas.Trans the dot products
+// are taken down the columns of A with stride lda.
+func (Implementation) DgemvArgmax(tA blas.Transpose, m, n int, a []float64, lda int, x []float64, incX int) (argmax int, maxVal float64) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+
+	// Quick return if possible.
+	if lenY == 0 {
+		return -1, math.NaN()
+	}
+	if lenX == 0 {
+		// Every element of the empty product is zero.
+		return 0, 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+
+	maxVal = math.NaN()
+	for i := 0; i < lenY; i++ {
+		v := dgemvElem(tA, i, m, n, a, lda, x, incX, kx)
+		if math.IsNaN(v) {
+			continue
+		}
+		if v > maxVal || math.IsNaN(maxVal) {
+			argmax = i
+			maxVal = v
+		}
+	}
+	return argmax, maxVal
+}
+
+// dgemvElem returns the element i of A * x if tA is blas.NoTrans and of Aᵀ * x
+// otherwise, where kx is the index of the first element of x.
+func dgemvElem(tA blas.Transpose, i, m, n int, a []float64, lda int, x []float64, incX, kx int) float64 {
+	switch {
+	case tA == blas.NoTrans && incX == 1:
+		return f64.DotUnitary(a[i*lda:i*lda+n], x[:n])
+	case tA == blas.NoTrans:
+		return f64.DotInc(a[i*lda:i*lda+n], x, uintptr(n), 1, uintptr(incX), 0, uintptr(kx))
+	default:
+		return f64.DotInc(a, x, uintptr(m), uintptr(lda), uintptr(incX), uintptr(i), uintptr(kx))
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestDgemvArgmax(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}, {20, 20}, {50, 7}} {
+			for _, incX := range []int{1, -3} {
+				m, n := mn.m, mn.n
+				name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d", tA, m, n, incX)
+				lenX, lenY := n, m
+				if tA != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				lda := n + 1
+				a := randomDense(rnd, m, n, lda)
+				x := randvec(lenX, incX, rnd)
+
+				// Compute the full product with Dgemv and scan it.
+				z := make([]float64, lenY)
+				impl.Dgemv(tA, m, n, 1, a, lda, x, incX, 0, z, 1)
+				want := floats.MaxIdx(z)
+
+				argmax, maxVal := impl.DgemvArgmax(tA, m, n, a, lda, x, incX)
+				if argmax != want {
+					t.Errorf("%s: unexpected argmax: got %d, want %d", name, argmax, want)
+				}
+				if math.Abs(maxVal-z[want]) > tol {
+					t.Errorf("%s: unexpected max: got %v, want %v", name, maxVal, z[want])
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvArgmaxSpecial(t *testing.T) {
+	nan := math.NaN()
+	for _, test := range []struct {
+		name    string
+		a       []float64
+		want    int
+		wantMax float64
+	}{
+		{name: "ties", a: []float64{1, 3, 2, 3, 3}, want: 1, wantMax: 3},
+		{name: "NaN", a: []float64{1, nan, 2, nan}, want: 2, wantMax: 2},
+		{name: "leading NaN", a: []float64{nan, -1, -2}, want: 1, wantMax: -1},
+		{name: "all NaN", a: []float64{nan, nan}, want: 0, wantMax: nan},
+		{name: "-Inf", a: []float64{math.Inf(-1), math.Inf(-1)}, want: 0, wantMax: math.Inf(-1)},
+	} {
+		// A is a column, so z = A * 1 is the column itself.
+		m := len(test.a)
+		argmax, maxVal := impl.DgemvArgmax(blas.NoTrans, m, 1, test.a, 1, []float64{1}, 1)
+		if argmax != test.want || !scalar.Same(maxVal, test.wantMax) {
+			t.Errorf("%s: unexpected result: got (%d, %v), want (%d, %v)", test.name, argmax, maxVal, test.want, test.wantMax)
+		}
+		argmax, maxVal = impl.DgemvArgmax(blas.Trans, 1, m, test.a, m, []float64{1}, 1)
+		if argmax != test.want || !scalar.Same(maxVal, test.wantMax) {
+			t.Errorf("%s: unexpected transposed result: got (%d, %v), want (%d, %v)", test.name, argmax, maxVal, test.want, test.wantMax)
+		}
+	}
+
+	argmax, maxVal := impl.DgemvArgmax(blas.NoTrans, 0, 3, nil, 3, nil, 1)
+	if argmax != -1 || !math.IsNaN(maxVal) {
+		t.Errorf("unexpected result for empty product: got (%d, %v), want (-1, NaN)", argmax, maxVal)
+	}
+	argmax, maxVal = impl.DgemvArgmax(blas.NoTrans, 3, 0, nil, 1, nil, 1)
+	if argmax != 0 || maxVal != 0 {
+		t.Errorf("unexpected result for product with no columns: got (%d, %v), want (0, 0)", argmax, maxVal)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DgemvBackward computes the gradients of the matrix-vector product
+//  y = A * x
+// where A is an m×n dense matrix and x is a vector, with respect to x and A
+// given the gradient gradY of a scalar loss with respect to y:
+//  gradX = Aᵀ * gradY
+//  gradA = gradY * xᵀ
+// gradX is returned as a vector of length n with unit increment and gradA as
+// an m×n dense matrix with leading dimension n. gradX is computed with Dgemv
+// and gradA with Dger.
+func (impl Implementation) DgemvBackward(m, n int, a []float64, lda int, x []float64, incX int, gradY []float64, incGY int) (gradX, gradA []float64) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incGY == 0 {
+		panic(zeroIncY)
+	}
+
+	gradX = make([]float64, n)
+	gradA = make([]float64, m*n)
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return gradX, gradA
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incGY > 0 && len(gradY) <= (m-1)*incGY) || (incGY < 0 && len(gradY) <= (1-m)*incGY) {
+		panic(shortY)
+	}
+
+	impl.Dgemv(blas.Trans, m, n, 1, a, lda, gradY, incGY, 0, gradX, 1)
+	impl.Dger(m, n, 1, gradY, incGY, x, incX, gradA, n)
+	return gradX, gradA
+}
@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvBackward(t *testing.T) {
+	const (
+		h   = 1e-4
+		tol = 1e-8
+	)
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{0, 3}, {3, 0}, {1, 1}, {1, 4}, {5, 1}, {4, 6}, {9, 7}} {
+		m, n := dims[0], dims[1]
+		for _, lda := range []int{max(1, n), n + 2} {
+			for _, inc := range []struct{ x, gy int }{{1, 1}, {2, 3}, {-1, 2}, {3, -1}} {
+				name := fmt.Sprintf("m=%v,n=%v,lda=%v,incX=%v,incGY=%v", m, n, lda, inc.x, inc.gy)
+				a := randomDense(rnd, m, n, lda)
+				x := randvec(n, inc.x, rnd)
+				gradY := randvec(m, inc.gy, rnd)
+
+				// loss returns gradYᵀ * A * x, whose gradients with respect
+				// to x and A are those computed by DgemvBackward.
+				y := make([]float64, m)
+				loss := func() float64 {
+					if m == 0 || n == 0 {
+						return 0
+					}
+					impl.Dgemv(blas.NoTrans, m, n, 1, a, lda, x, inc.x, 0, y, 1)
+					g := make([]float64, m)
+					impl.Dcopy(m, gradY, inc.gy, g, 1)
+					return floats.Dot(g, y)
+				}
+				centralDiff := func(v *float64) float64 {
+					orig := *v
+					*v = orig + h
+					fp := loss()
+					*v = orig - h
+					fm := loss()
+					*v = orig
+					return (fp - fm) / (2 * h)
+				}
+
+				gradX, gradA := impl.DgemvBackward(m, n, a, lda, x, inc.x, gradY, inc.gy)
+				if len(gradX) != n || len(gradA) != m*n {
+					t.Errorf("%v: unexpected result lengths: got %v and %v, want %v and %v", name, len(gradX), len(gradA), n, m*n)
+					continue
+				}
+				for j := 0; j < n; j++ {
+					want := centralDiff(&x[vecIndex(j, n, inc.x)])
+					if !floats.EqualWithinAbsOrRel(gradX[j], want, tol, tol) {
+						t.Errorf("%v: unexpected gradX[%v]: got %v, want %v", name, j, gradX[j], want)
+					}
+				}
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						want := centralDiff(&a[i*lda+j])
+						if !floats.EqualWithinAbsOrRel(gradA[i*n+j], want, tol, tol) {
+							t.Errorf("%v: unexpected gradA[%v,%v]: got %v, want %v", name, i, j, gradA[i*n+j], want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
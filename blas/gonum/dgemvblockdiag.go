@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Block is a dense Rows×Cols matrix stored in row-major order in Data with
+// leading dimension Stride.
+type Block struct {
+	Rows, Cols int
+	Stride     int
+	Data       []float64
+}
+
+// DgemvBlockDiag performs one of the matrix-vector operations
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is the block-diagonal matrix with the dense blocks along its diagonal,
+// x and y are vectors, and alpha and beta are scalars. A is m×n where m and n
+// are the sums of the rows and of the columns of the blocks. The elements of A
+// outside the blocks are zero and are neither stored nor multiplied.
+//
+// Each block is applied with Dgemv to the segments of x and y corresponding
+// to its columns and rows. Unlike Dgemv, DgemvBlockDiag scales the segment of
+// y belonging to a block with no columns (rows for tA = blas.Trans) by beta,
+// as a Dgemv call with the assembled matrix does.
+func (impl Implementation) DgemvBlockDiag(tA blas.Transpose, alpha float64, blocks []Block, x []float64, incX int, beta float64, y []float64, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	var m, n int
+	for _, b := range blocks {
+		if b.Rows < 0 {
+			panic(mLT0)
+		}
+		if b.Cols < 0 {
+			panic(nLT0)
+		}
+		if b.Stride < max(1, b.Cols) {
+			panic(badLdA)
+		}
+		if b.Rows > 0 && b.Cols > 0 && len(b.Data) < b.Stride*(b.Rows-1)+b.Cols {
+			panic(shortA)
+		}
+		m += b.Rows
+		n += b.Cols
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+
+	var offX, offY int
+	for _, b := range blocks {
+		bX, bY := b.Cols, b.Rows
+		if tA != blas.NoTrans {
+			bX, bY = b.Rows, b.Cols
+		}
+		if bY > 0 {
+			ySeg := segment(y, incY, lenY, offY, bY)
+			if bX == 0 {
+				if incY > 0 {
+					impl.Dscal(bY, beta, ySeg, incY)
+				} else {
+					impl.Dscal(bY, beta, ySeg, -incY)
+				}
+			} else {
+				xSeg := segment(x, incX, lenX, offX, bX)
+				impl.Dgemv(tA, b.Rows, b.Cols, alpha, b.Data, b.Stride, xSeg, incX, beta, ySeg, incY)
+			}
+		}
+		offX += bX
+		offY += bY
+	}
+}
+
+// segment returns the subslice of the vector x of length n with increment inc
+// that holds the l elements starting at the element with index off, for use
+// with the same increment.
+func segment(x []float64, inc, n, off, l int) []float64 {
+	if inc > 0 {
+		return x[off*inc:]
+	}
+	return x[(n-off-l)*-inc:]
+}
@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvBlockDiag(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, sizes := range [][][2]int{
+		{{1, 1}},
+		{{3, 3}},
+		{{2, 3}, {4, 1}},
+		{{1, 1}, {2, 2}, {3, 3}},
+		{{3, 2}, {0, 2}, {2, 0}, {5, 4}},
+		{{7, 9}, {4, 4}, {1, 6}},
+	} {
+		// Build the blocks with padding beyond their columns and assemble
+		// the full block-diagonal matrix.
+		blocks := make([]Block, len(sizes))
+		var m, n int
+		for k, s := range sizes {
+			stride := s[1] + k
+			if stride == 0 {
+				stride = 1
+			}
+			data := randomDense(rnd, s[0], s[1], stride)
+			blocks[k] = Block{Rows: s[0], Cols: s[1], Stride: stride, Data: data}
+			m += s[0]
+			n += s[1]
+		}
+		lda := max(1, n)
+		a := make([]float64, m*lda)
+		var r0, c0 int
+		for _, b := range blocks {
+			for i := 0; i < b.Rows; i++ {
+				copy(a[(r0+i)*lda+c0:(r0+i)*lda+c0+b.Cols], b.Data[i*b.Stride:i*b.Stride+b.Cols])
+			}
+			r0 += b.Rows
+			c0 += b.Cols
+		}
+
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 1}, {3, -2}, {-2, -1}} {
+				for _, ab := range [][2]float64{{0, 0.5}, {1, 0}, {-1.5, 2}, {0.5, 1}} {
+					alpha, beta := ab[0], ab[1]
+					name := fmt.Sprintf("sizes=%v,tA=%v,incX=%v,incY=%v,alpha=%v,beta=%v", sizes, tA, inc.x, inc.y, alpha, beta)
+					x := randvec(lenX, inc.x, rnd)
+					y := randvec(lenY, inc.y, rnd)
+
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dgemv(tA, m, n, alpha, a, lda, x, inc.x, beta, want, inc.y)
+
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DgemvBlockDiag(tA, alpha, blocks, x, inc.x, beta, got, inc.y)
+
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%v: result differs from Dgemv\nwant %v\ngot  %v", name, want, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvBlockDiagPanics(t *testing.T) {
+	blocks := []Block{{Rows: 2, Cols: 2, Stride: 2, Data: make([]float64, 4)}}
+	badStride := []Block{{Rows: 2, Cols: 2, Stride: 1, Data: make([]float64, 4)}}
+	shortData := []Block{{Rows: 2, Cols: 2, Stride: 2, Data: make([]float64, 3)}}
+	negRows := []Block{{Rows: -1, Cols: 1, Stride: 1}}
+	x := make([]float64, 2)
+	y := make([]float64, 2)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"bad transpose", func() { impl.DgemvBlockDiag('X', 1, blocks, x, 1, 0, y, 1) }},
+		{"negative rows", func() { impl.DgemvBlockDiag(blas.NoTrans, 1, negRows, x, 1, 0, y, 1) }},
+		{"bad stride", func() { impl.DgemvBlockDiag(blas.NoTrans, 1, badStride, x, 1, 0, y, 1) }},
+		{"short data", func() { impl.DgemvBlockDiag(blas.NoTrans, 1, shortData, x, 1, 0, y, 1) }},
+		{"zero incX", func() { impl.DgemvBlockDiag(blas.NoTrans, 1, blocks, x, 0, 0, y, 1) }},
+		{"short x", func() { impl.DgemvBlockDiag(blas.NoTrans, 1, blocks, x[:1], 1, 0, y, 1) }},
+		{"short y", func() { impl.DgemvBlockDiag(blas.NoTrans, 1, blocks, x, 1, 0, y, 2) }},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%v: expected panic", test.name)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvCallback computes
+//  y = alpha * A * x   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x is a vector and alpha is a scalar, and
+// passes each element of y to emit instead of storing it. emit is called
+// exactly once for each i in increasing order with the value of y[i], so the
+// caller never has to allocate y.
+//
+// If tA == blas.NoTrans, each y[i] is the dot product of row i of A with x
+// and is emitted as soon as that row has been processed. Otherwise the
+// elements of y accumulate over all rows of A, so they are formed in a
+// temporary of length n and emitted after the last row has been read.
+//
+// If alpha is zero, or A has no columns in the product, the zero elements of
+// y are emitted without referencing A.
+func (Implementation) DgemvCallback(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, emit func(i int, val float64)) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+
+	// Quick return if possible.
+	if lenY == 0 {
+		return
+	}
+	if m == 0 || n == 0 || alpha == 0 {
+		for i := 0; i < lenY; i++ {
+			emit(i, 0)
+		}
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if tA == blas.NoTrans {
+		for i := 0; i < m; i++ {
+			var dot float64
+			if incX == 1 {
+				dot = f64.DotUnitary(a[i*lda:i*lda+n], x[:n])
+			} else {
+				dot = f64.DotInc(x, a[i*lda:i*lda+n], uintptr(n), uintptr(incX), 1, uintptr(kx), 0)
+			}
+			emit(i, alpha*dot)
+		}
+		return
+	}
+
+	y := make([]float64, n)
+	ix := kx
+	for i := 0; i < m; i++ {
+		f64.AxpyUnitary(alpha*x[ix], a[i*lda:i*lda+n], y)
+		ix += incX
+	}
+	for j, v := range y {
+		emit(j, v)
+	}
+}
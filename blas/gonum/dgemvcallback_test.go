@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvCallback(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {1, 4}, {4, 1}, {5, 5}, {7, 3}, {3, 9}} {
+			m, n := mn[0], mn[1]
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			for _, lda := range []int{max(1, n), n + 3} {
+				a := randvec(max(0, lda*(m-1)+n), 1, rnd)
+				for _, incX := range []int{1, 2, -3} {
+					x := randvec(lenX, incX, rnd)
+					for _, alpha := range []float64{0, 1, -2.5} {
+						want := make([]float64, lenY)
+						if lenY > 0 {
+							impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, 0, want, 1)
+						}
+
+						var (
+							got  []float64
+							next int
+						)
+						impl.DgemvCallback(tA, m, n, alpha, a, lda, x, incX, func(i int, val float64) {
+							if i != next {
+								t.Errorf("tA=%v,m=%v,n=%v,lda=%v,incX=%v,alpha=%v: unexpected emit order: want index %v, got %v",
+									tA, m, n, lda, incX, alpha, next, i)
+							}
+							next++
+							got = append(got, val)
+						})
+
+						if len(got) != lenY {
+							t.Errorf("tA=%v,m=%v,n=%v,lda=%v,incX=%v,alpha=%v: unexpected number of emitted values: want %v, got %v",
+								tA, m, n, lda, incX, alpha, lenY, len(got))
+							continue
+						}
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("tA=%v,m=%v,n=%v,lda=%v,incX=%v,alpha=%v: result differs from Dgemv\nwant %v\ngot  %v",
+								tA, m, n, lda, incX, alpha, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvCallbackPanics(t *testing.T) {
+	a := make([]float64, 6)
+	x := make([]float64, 3)
+	emit := func(int, float64) {}
+	if !panics(func() { impl.DgemvCallback('X', 2, 3, 1, a, 3, x, 1, emit) }) {
+		t.Errorf("expected panic for bad transpose")
+	}
+	if !panics(func() { impl.DgemvCallback(blas.NoTrans, 2, 3, 1, a, 2, x, 1, emit) }) {
+		t.Errorf("expected panic for bad lda")
+	}
+	if !panics(func() { impl.DgemvCallback(blas.NoTrans, 2, 3, 1, a, 3, x, 0, emit) }) {
+		t.Errorf("expected panic for zero incX")
+	}
+	if !panics(func() { impl.DgemvCallback(blas.NoTrans, 2, 3, 1, a[:5], 3, x, 1, emit) }) {
+		t.Errorf("expected panic for short a")
+	}
+	if !panics(func() { impl.DgemvCallback(blas.NoTrans, 2, 3, 1, a, 3, x[:2], 1, emit) }) {
+		t.Errorf("expected panic for short x")
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DgemvChecked computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// using Dgemv and reports whether the result passes an algorithm-based fault
+// tolerance check.
+//
+// The check uses the identity that the sum of the elements of A * x is the
+// dot product of the column sums of A with x, and that of Aᵀ * x is the dot
+// product of the row sums of A with x. The expected sum of y is computed from
+// A, x and the incoming y before the product, and DgemvChecked returns false
+// if the sum of the result differs from it by more than a bound on the
+// rounding error. A corrupted element of y is detected when its error exceeds
+// that bound, which is proportional to m+n times the unit roundoff times the
+// sum of the magnitudes of the terms; smaller errors are indistinguishable
+// from rounding. DgemvChecked also returns false if the result contains NaN
+// or infinite values.
+func (impl Implementation) DgemvChecked(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) (ok bool) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return true
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// The checksum is formed before y is updated since x may share storage
+	// with y.
+	want, tol := dgemvChecksum(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
+	impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
+	return checksumAgrees(lenY, y, incY, want, tol)
+}
+
+// dgemvChecksum returns the expected sum of the elements of the result of
+// Dgemv called with the same arguments, and a bound on the difference between
+// that and the computed sum that is due to rounding.
+func dgemvChecksum(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) (want, tol float64) {
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// sums[k] and abs[k] hold the sum and the sum of magnitudes of the
+	// elements of A that multiply element k of x.
+	sums := make([]float64, 2*lenX)
+	sums, abs := sums[:lenX], sums[lenX:]
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			k := j
+			if tA != blas.NoTrans {
+				k = i
+			}
+			sums[k] += v
+			abs[k] += math.Abs(v)
+		}
+	}
+	var dot, mag float64
+	ix := kx
+	for k, s := range sums {
+		dot += s * x[ix]
+		mag += abs[k] * math.Abs(x[ix])
+		ix += incX
+	}
+	want = alpha * dot
+	mag *= math.Abs(alpha)
+
+	if beta != 0 {
+		var sumY, magY float64
+		iy := ky
+		for i := 0; i < lenY; i++ {
+			sumY += y[iy]
+			magY += math.Abs(y[iy])
+			iy += incY
+		}
+		want += beta * sumY
+		mag += math.Abs(beta) * magY
+	}
+
+	// Both the product and the checksum accumulate at most m+n+2 rounding
+	// errors of relative size eps in each of their terms.
+	const eps = 0x1p-53
+	return want, 2 * float64(m+n+2) * eps * mag
+}
+
+// checksumAgrees returns whether the sum of the n elements of y with
+// increment incY is within tol of want.
+func checksumAgrees(n int, y []float64, incY int, want, tol float64) bool {
+	var iy int
+	if incY < 0 {
+		iy = (1 - n) * incY
+	}
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += y[iy]
+		iy += incY
+	}
+	return math.Abs(sum-want) <= tol
+}
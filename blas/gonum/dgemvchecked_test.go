@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvChecked(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}, {40, 40}, {100, 7}} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, -3}} {
+				for _, ab := range []struct{ alpha, beta float64 }{{1, 0}, {-0.5, 2}, {3, 1}} {
+					m, n := mn.m, mn.n
+					name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,incY=%d,alpha=%v,beta=%v", tA, m, n, inc.x, inc.y, ab.alpha, ab.beta)
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					lda := n + 1
+					a := randomDense(rnd, m, n, lda)
+					x := randvec(lenX, inc.x, rnd)
+					y := randvec(lenY, inc.y, rnd)
+
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dgemv(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, want, inc.y)
+					got := make([]float64, len(y))
+					copy(got, y)
+					if !impl.DgemvChecked(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, got, inc.y) {
+						t.Errorf("%s: correct result failed the check", name)
+					}
+					if !floats.Same(got, want) {
+						t.Errorf("%s: result differs from Dgemv", name)
+					}
+
+					// Flipping the lowest exponent bit of any element halves
+					// or doubles it, which the check must detect.
+					sum, tol := dgemvChecksum(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, y, inc.y)
+					for i := 0; i < lenY; i++ {
+						iy := vecIndex(i, lenY, inc.y)
+						v := got[iy]
+						got[iy] = math.Float64frombits(math.Float64bits(v) ^ 1<<52)
+						if checksumAgrees(lenY, got, inc.y, sum, tol) {
+							t.Errorf("%s: corrupted element %d not detected", name, i)
+						}
+						got[iy] = v
+					}
+					if !checksumAgrees(lenY, got, inc.y, sum, tol) {
+						t.Errorf("%s: restored result failed the check", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvCheckedAlias(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 33} {
+		a := randomDense(rnd, n, n, n)
+		x := randvec(n, 1, rnd)
+		want := make([]float64, n)
+		copy(want, x)
+		impl.Dgemv(blas.NoTrans, n, n, 1.5, a, n, x, 1, 0.5, want, 1)
+		if !impl.DgemvChecked(blas.NoTrans, n, n, 1.5, a, n, x, 1, 0.5, x, 1) {
+			t.Errorf("n=%d: result with x and y the same vector failed the check", n)
+		}
+		if !floats.Same(x, want) {
+			t.Errorf("n=%d: result with x and y the same vector differs from Dgemv", n)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// DgemvContributions computes the terms of the dot product that forms
+// element row of y = A * x
+//  out[j] = A[row][j] * x[j]  for j = 0, ..., n-1
+// where A is an m×n dense matrix and x is a vector. The sum of out is y[row]
+// up to the rounding of the summation, so out shows how much each element of
+// x contributes to that output.
+func (Implementation) DgemvContributions(m, n int, a []float64, lda int, x []float64, incX int, row int, out []float64) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if row < 0 {
+		panic(rowIndexLT0)
+	}
+	if row >= m {
+		panic(badRowIndex)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(out) < n {
+		panic(shortOut)
+	}
+
+	var ix int
+	if incX < 0 {
+		ix = -(n - 1) * incX
+	}
+	for j, v := range a[row*lda : row*lda+n] {
+		out[j] = v * x[ix]
+		ix += incX
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvContributions(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {1, 5}, {4, 1}, {6, 7}, {3, 20}} {
+		m, n := dims[0], dims[1]
+		for _, lda := range []int{n, n + 2} {
+			for _, incX := range []int{1, 3, -1, -2} {
+				a := randomDense(rnd, m, n, lda)
+				x := randvec(n, incX, rnd)
+				y := make([]float64, m)
+				impl.Dgemv(blas.NoTrans, m, n, 1, a, lda, x, incX, 0, y, 1)
+				for row := 0; row < m; row++ {
+					name := fmt.Sprintf("m=%v,n=%v,lda=%v,incX=%v,row=%v", m, n, lda, incX, row)
+					out := make([]float64, n+1)
+					out[n] = -1
+					impl.DgemvContributions(m, n, a, lda, x, incX, row, out)
+					if out[n] != -1 {
+						t.Errorf("%v: out modified beyond n", name)
+					}
+					for j := 0; j < n; j++ {
+						if want := a[row*lda+j] * x[vecIndex(j, n, incX)]; out[j] != want {
+							t.Errorf("%v: unexpected out[%v]: got %v, want %v", name, j, out[j], want)
+						}
+					}
+					if sum := floats.Sum(out[:n]); !floats.EqualWithinAbsOrRel(sum, y[row], tol, tol) {
+						t.Errorf("%v: sum of contributions %v differs from Dgemv result %v", name, sum, y[row])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvContributionsPanics(t *testing.T) {
+	a := make([]float64, 6)
+	x := make([]float64, 3)
+	out := make([]float64, 3)
+	for _, test := range []struct {
+		name string
+		f    func()
+		want string
+	}{
+		{"negative row", func() { impl.DgemvContributions(2, 3, a, 3, x, 1, -1, out) }, rowIndexLT0},
+		{"row too large", func() { impl.DgemvContributions(2, 3, a, 3, x, 1, 2, out) }, badRowIndex},
+		{"short out", func() { impl.DgemvContributions(2, 3, a, 3, x, 1, 1, out[:2]) }, shortOut},
+		{"short x", func() { impl.DgemvContributions(2, 3, a, 3, x, 2, 1, out) }, shortX},
+	} {
+		if msg := panicValue(test.f); msg != test.want {
+			t.Errorf("%v: unexpected panic: got %v, want %v", test.name, msg, test.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DgemvFunc computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n linear operator that is not stored explicitly, x and y
+// are vectors, and alpha and beta are scalars.
+//
+// The action of the operator is given by apply, which must store the product
+// of the operator with the dense vector x into the dense vector y. apply is
+// called with len(x) == n and len(y) == m when tA == blas.NoTrans, and it
+// must then compute A*x. Otherwise it is called with len(x) == m and
+// len(y) == n, and it must compute the adjoint product Aᵀ*x, so the caller's
+// apply has to know which product is requested. apply must not retain x or
+// y, and y may hold arbitrary values on entry. apply is not called when alpha
+// is zero.
+func (Implementation) DgemvFunc(tA blas.Transpose, m, n int, alpha float64, apply func(x, y []float64), x []float64, incX int, beta float64, y []float64, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	var tmp []float64
+	if alpha != 0 {
+		// Gather x into a dense vector, which also keeps apply from
+		// modifying the caller's x, and compute the operator product.
+		xd := make([]float64, lenX)
+		ix := kx
+		for i := range xd {
+			xd[i] = x[ix]
+			ix += incX
+		}
+		tmp = make([]float64, lenY)
+		apply(xd, tmp)
+	}
+
+	iy := ky
+	for i := 0; i < lenY; i++ {
+		var v float64
+		if alpha != 0 {
+			v = alpha * tmp[i]
+		}
+		if beta != 0 {
+			v += beta * y[iy]
+		}
+		y[iy] = v
+		iy += incY
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgemvFunc(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {1, 5}, {4, 1}, {6, 6}, {7, 3}, {3, 8}} {
+			m, n := mn[0], mn[1]
+			for _, inc := range [][2]int{{1, 1}, {2, 3}, {-1, 2}, {3, -2}} {
+				incX, incY := inc[0], inc[1]
+				for _, ab := range [][2]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {-1.5, 0.5}} {
+					alpha, beta := ab[0], ab[1]
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					lda := n + 1
+					a := randvec(m*lda, 1, rnd)
+					x := randvec(lenX, incX, rnd)
+					y := randvec(lenY, incY, rnd)
+
+					// The operator wraps the explicit matrix and computes
+					// the product requested by tA.
+					var calls int
+					apply := func(xd, yd []float64) {
+						calls++
+						if len(xd) != lenX || len(yd) != lenY {
+							t.Fatalf("unexpected lengths in apply: len(x)=%v, len(y)=%v", len(xd), len(yd))
+						}
+						impl.Dgemv(tA, m, n, 1, a, lda, xd, 1, 0, yd, 1)
+					}
+
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, want, incY)
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DgemvFunc(tA, m, n, alpha, apply, x, incX, beta, got, incY)
+
+					name := fmt.Sprintf("tA=%c,m=%v,n=%v,incX=%v,incY=%v,alpha=%v,beta=%v", tA, m, n, incX, incY, alpha, beta)
+					wantCalls := 1
+					if alpha == 0 {
+						wantCalls = 0
+					}
+					if calls != wantCalls {
+						t.Errorf("%v: unexpected number of apply calls: got %v, want %v", name, calls, wantCalls)
+					}
+					for i := range want {
+						if math.Abs(got[i]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+							t.Errorf("%v: result differs from Dgemv at %v: want %v, got %v", name, i, want[i], got[i])
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
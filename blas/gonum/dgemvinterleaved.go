@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DgemvInterleaved performs one of the complex matrix-vector operations
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans
+//  y = alpha * Aᴴ * x + beta * y  if tA = blas.ConjTrans
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n dense
+// matrix, with the complex elements of A, x and y stored as interleaved pairs
+// of real and imaginary parts in float64 slices.
+//
+// The complex element (i, j) of A is stored in a[2*(i*lda+j)] and
+// a[2*(i*lda+j)+1], and the complex element i of x is stored in x[2*i*incX]
+// and x[2*i*incX+1]. The leading dimension lda and the increments incX and
+// incY are counted in complex elements. DgemvInterleaved returns the same
+// result as Zgemv called with the equivalent complex128 slices.
+func (Implementation) DgemvInterleaved(tA blas.Transpose, m, n int, alpha complex128, a []float64, lda int, x []float64, incX int, beta complex128, y []float64, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if len(a) < 2*(lda*(m-1)+n) {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) < 2*((lenX-1)*incX+1)) || (incX < 0 && len(x) < 2*((1-lenX)*incX+1)) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) < 2*((lenY-1)*incY+1)) || (incY < 0 && len(y) < 2*((1-lenY)*incY+1)) {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	var ky int
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// Form y = beta*y.
+	if beta != 1 {
+		br, bi := real(beta), imag(beta)
+		iy := ky
+		for i := 0; i < lenY; i++ {
+			if beta == 0 {
+				y[2*iy] = 0
+				y[2*iy+1] = 0
+			} else {
+				yr, yi := y[2*iy], y[2*iy+1]
+				y[2*iy] = br*yr - bi*yi
+				y[2*iy+1] = br*yi + bi*yr
+			}
+			iy += incY
+		}
+	}
+
+	if alpha == 0 {
+		return
+	}
+
+	if tA == blas.NoTrans {
+		// Form y = alpha*A*x + y.
+		iy := ky
+		for i := 0; i < m; i++ {
+			aRow := a[2*i*lda : 2*(i*lda+n)]
+			var sr, si float64
+			jx := kx
+			for j := 0; j < n; j++ {
+				ar, ai := aRow[2*j], aRow[2*j+1]
+				xr, xi := x[2*jx], x[2*jx+1]
+				sr += ar*xr - ai*xi
+				si += ar*xi + ai*xr
+				jx += incX
+			}
+			y[2*iy] += real(alpha)*sr - imag(alpha)*si
+			y[2*iy+1] += real(alpha)*si + imag(alpha)*sr
+			iy += incY
+		}
+		return
+	}
+
+	// Form y = alpha*Aᵀ*x + y or y = alpha*Aᴴ*x + y.
+	conj := 1.0
+	if tA == blas.ConjTrans {
+		conj = -1
+	}
+	ix := kx
+	for i := 0; i < m; i++ {
+		xr, xi := x[2*ix], x[2*ix+1]
+		tr := real(alpha)*xr - imag(alpha)*xi
+		ti := real(alpha)*xi + imag(alpha)*xr
+		aRow := a[2*i*lda : 2*(i*lda+n)]
+		jy := ky
+		for j := 0; j < n; j++ {
+			ar, ai := aRow[2*j], conj*aRow[2*j+1]
+			y[2*jy] += tr*ar - ti*ai
+			y[2*jy+1] += tr*ai + ti*ar
+			jy += incY
+		}
+		ix += incX
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgemvInterleaved(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+		for _, mn := range [][2]int{{1, 1}, {1, 4}, {3, 1}, {4, 5}, {7, 3}} {
+			m, n := mn[0], mn[1]
+			for _, inc := range [][2]int{{1, 1}, {2, 3}, {-3, 2}, {-2, -4}} {
+				for _, lda := range []int{n, n + 3} {
+					for _, ab := range [][2]complex128{{0, 0}, {0, 1}, {1, 0}, {2 - 1i, 0.5 + 3i}} {
+						testDgemvInterleaved(t, rnd, tA, m, n, lda, inc[0], inc[1], ab[0], ab[1])
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDgemvInterleaved(t *testing.T, rnd *rand.Rand, tA blas.Transpose, m, n, lda, incX, incY int, alpha, beta complex128) {
+	const tol = 1e-14
+
+	lenX, lenY := n, m
+	if tA != blas.NoTrans {
+		lenX, lenY = m, n
+	}
+	a := randvec(2*(m*lda), 1, rnd)
+	x := randvec(2*(1+(lenX-1)*abs(incX)), 1, rnd)
+	y := randvec(2*(1+(lenY-1)*abs(incY)), 1, rnd)
+
+	ac := interleavedToComplex(a)
+	xc := interleavedToComplex(x)
+	want := interleavedToComplex(y)
+	impl.Zgemv(tA, m, n, alpha, ac, lda, xc, incX, beta, want, incY)
+
+	got := make([]float64, len(y))
+	copy(got, y)
+	impl.DgemvInterleaved(tA, m, n, alpha, a, lda, x, incX, beta, got, incY)
+
+	prefix := fmt.Sprintf("tA=%v,m=%v,n=%v,lda=%v,incX=%v,incY=%v,alpha=%v,beta=%v", tA, m, n, lda, incX, incY, alpha, beta)
+	for i, w := range want {
+		g := complex(got[2*i], got[2*i+1])
+		if cmplx.Abs(g-w) > tol*math.Max(1, cmplx.Abs(w)) {
+			t.Errorf("%v: result differs from Zgemv at %v: want %v, got %v", prefix, i, w, g)
+		}
+	}
+}
+
+// interleavedToComplex returns the complex numbers stored as interleaved
+// real and imaginary parts in x.
+func interleavedToComplex(x []float64) []complex128 {
+	c := make([]complex128, len(x)/2)
+	for i := range c {
+		c[i] = complex(x[2*i], x[2*i+1])
+	}
+	return c
+}
@@ -0,0 +1,92 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgemvMasked(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	randMask := func(n int) []bool {
+		mask := make([]bool, n)
+		for i := range mask {
+			mask[i] = rnd.Intn(3) != 0
+		}
+		return mask
+	}
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {1, 4}, {4, 1}, {5, 5}, {6, 9}, {9, 6}} {
+			m, n := mn[0], mn[1]
+			for _, inc := range [][2]int{{1, 1}, {2, -1}, {-3, 2}} {
+				incX, incY := inc[0], inc[1]
+				for _, ab := range [][2]float64{{0, 0}, {1, 0}, {1, 1}, {-0.5, 2}} {
+					alpha, beta := ab[0], ab[1]
+					for _, masks := range [][2][]bool{{nil, nil}, {randMask(m), nil}, {nil, randMask(n)}, {randMask(m), randMask(n)}} {
+						rowMask, colMask := masks[0], masks[1]
+						lenX, lenY := n, m
+						if tA != blas.NoTrans {
+							lenX, lenY = m, n
+						}
+						lda := n + 2
+						a := randvec(m*lda, 1, rnd)
+						x := randvec(lenX, incX, rnd)
+						y := randvec(lenY, incY, rnd)
+
+						// The masked rows and columns must not be
+						// referenced, so they are NaN in the input to
+						// DgemvMasked and zero in the input to Dgemv.
+						aNaN := make([]float64, len(a))
+						copy(aNaN, a)
+						for i := 0; i < m; i++ {
+							for j := 0; j < n; j++ {
+								if (rowMask != nil && !rowMask[i]) || (colMask != nil && !colMask[j]) {
+									a[i*lda+j] = 0
+									aNaN[i*lda+j] = math.NaN()
+								}
+							}
+						}
+
+						want := make([]float64, len(y))
+						copy(want, y)
+						impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, want, incY)
+						got := make([]float64, len(y))
+						copy(got, y)
+						impl.DgemvMasked(tA, m, n, alpha, aNaN, lda, x, incX, beta, got, incY, rowMask, colMask)
+
+						name := fmt.Sprintf("tA=%c,m=%v,n=%v,incX=%v,incY=%v,alpha=%v,beta=%v,rowMask=%v,colMask=%v",
+							tA, m, n, incX, incY, alpha, beta, rowMask, colMask)
+						for i := range want {
+							if math.IsNaN(got[i]) || math.Abs(got[i]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+								t.Errorf("%v: result differs from Dgemv at %v: want %v, got %v", name, i, want[i], got[i])
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvMaskedPanics(t *testing.T) {
+	a := make([]float64, 6)
+	x := make([]float64, 3)
+	y := make([]float64, 3)
+	if !panics(func() { impl.DgemvMasked(blas.NoTrans, 2, 3, 1, a, 3, x, 1, 0, y, 1, make([]bool, 1), nil) }) {
+		t.Error("no panic for short rowMask")
+	}
+	if !panics(func() { impl.DgemvMasked(blas.NoTrans, 2, 3, 1, a, 3, x, 1, 0, y, 1, nil, make([]bool, 2)) }) {
+		t.Error("no panic for short colMask")
+	}
+}
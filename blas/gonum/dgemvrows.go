@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvRows computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix stored as the m rows a[0], ..., a[m-1] of
+// length n, x and y are vectors, and alpha and beta are scalars.
+//
+// m and n are taken from len(a) and len(a[0]), and DgemvRows panics if the
+// rows do not all have the same length. The rows need not be contiguous in
+// memory, so A can be used without copying it into a single slice. As in
+// Dgemv, y is not referenced if m or n is zero.
+func (Implementation) DgemvRows(tA blas.Transpose, alpha float64, a [][]float64, x []float64, incX int, beta float64, y []float64, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	m := len(a)
+	var n int
+	if m > 0 {
+		n = len(a[0])
+	}
+	for _, row := range a {
+		if len(row) != n {
+			panic(badRowLen)
+		}
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// Form y = beta * y.
+	dscalePrepY(lenY, beta, y, incY)
+
+	if alpha == 0 {
+		return
+	}
+
+	if tA == blas.NoTrans {
+		// Form y = alpha * A * x + y.
+		iy := ky
+		for _, row := range a {
+			var dot float64
+			if incX == 1 {
+				dot = f64.DotUnitary(row, x[:n])
+			} else {
+				dot = f64.DotInc(row, x, uintptr(n), 1, uintptr(incX), 0, uintptr(kx))
+			}
+			y[iy] += alpha * dot
+			iy += incY
+		}
+		return
+	}
+	// Form y = alpha * Aᵀ * x + y.
+	ix := kx
+	for _, row := range a {
+		if incY == 1 {
+			f64.AxpyUnitary(alpha*x[ix], row, y[:n])
+		} else {
+			f64.AxpyInc(alpha*x[ix], row, y, uintptr(n), 1, uintptr(incY), 0, uintptr(ky))
+		}
+		ix += incX
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvRows(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {1, 4}, {4, 1}, {5, 5}, {7, 3}, {3, 9}} {
+			m, n := mn[0], mn[1]
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			// The rows are separately allocated, so they are not
+			// contiguous in memory.
+			a := make([][]float64, m)
+			flat := make([]float64, m*n)
+			for i := range a {
+				a[i] = randvec(n, 1, rnd)
+				if a[i] == nil {
+					a[i] = []float64{}
+				}
+				copy(flat[i*n:], a[i])
+			}
+			for _, inc := range [][2]int{{1, 1}, {2, 3}, {-2, 1}, {1, -3}} {
+				incX, incY := inc[0], inc[1]
+				x := randvec(lenX, incX, rnd)
+				y := randvec(lenY, incY, rnd)
+				for _, ab := range [][2]float64{{0, 0}, {0, 0.5}, {1, 0}, {-2.5, 1}, {0.5, 2}} {
+					alpha, beta := ab[0], ab[1]
+					name := fmt.Sprintf("tA=%c,m=%v,n=%v,incX=%v,incY=%v,alpha=%v,beta=%v", tA, m, n, incX, incY, alpha, beta)
+
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dgemv(tA, m, n, alpha, flat, max(1, n), x, incX, beta, want, incY)
+
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DgemvRows(tA, alpha, a, x, incX, beta, got, incY)
+
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%v: result differs from Dgemv\nwant %v\ngot  %v", name, want, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvRowsPanics(t *testing.T) {
+	x := []float64{1, 2, 3}
+	y := []float64{0, 0}
+	ragged := [][]float64{{1, 2, 3}, {4, 5}}
+	if !panics(func() { impl.DgemvRows(blas.NoTrans, 1, ragged, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for rows of different lengths")
+	}
+	a := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	if !panics(func() { impl.DgemvRows('X', 1, a, x, 1, 0, y, 1) }) {
+		t.Errorf("expected panic for bad transpose")
+	}
+	if !panics(func() { impl.DgemvRows(blas.NoTrans, 1, a, x[:2], 1, 0, y, 1) }) {
+		t.Errorf("expected panic for short x")
+	}
+	if !panics(func() { impl.DgemvRows(blas.NoTrans, 1, a, x, 1, 0, y[:1], 1) }) {
+		t.Errorf("expected panic for short y")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// DgemvScaledColNorms computes the absolute column sums of A * diag(x)
+//  out[j] = sum_i |A[i][j] * x[j]|  for j = 0, ..., n-1
+// where A is an m×n dense matrix and x is a vector. The largest element of
+// out is the 1-norm of A * diag(x), which is computed in a single pass over A
+// without being formed. If m is zero, the first n elements of out are set to
+// zero.
+func (Implementation) DgemvScaledColNorms(m, n int, a []float64, lda int, x []float64, incX int, out []float64) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(out) < n {
+		panic(shortOut)
+	}
+
+	out = out[:n]
+	for j := range out {
+		out[j] = 0
+	}
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	for i := 0; i < m; i++ {
+		jx := kx
+		for j, v := range a[i*lda : i*lda+n] {
+			out[j] += math.Abs(v * x[jx])
+			jx += incX
+		}
+	}
+}
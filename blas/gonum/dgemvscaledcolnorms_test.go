@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvScaledColNorms(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range []struct{ m, n int }{{0, 3}, {1, 1}, {1, 4}, {4, 1}, {5, 7}, {20, 13}} {
+		for _, incX := range []int{1, 3, -2} {
+			m, n := mn.m, mn.n
+			name := fmt.Sprintf("m=%d,n=%d,incX=%d", m, n, incX)
+			lda := n + 2
+			a := randomDense(rnd, m, n, lda)
+			x := randvec(n, incX, rnd)
+
+			// Form A * diag(x) explicitly and sum the absolute values of
+			// its columns.
+			ad := make([]float64, m*n)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					ad[i*n+j] = a[i*lda+j] * x[vecIndex(j, n, incX)]
+				}
+			}
+			want := make([]float64, n+1)
+			want[n] = math.NaN()
+			for j := 0; j < n; j++ {
+				for i := 0; i < m; i++ {
+					want[j] += math.Abs(ad[i*n+j])
+				}
+			}
+
+			got := nanSlice(n + 1)
+			impl.DgemvScaledColNorms(m, n, a, lda, x, incX, got)
+			if !floats.Same(got, want) {
+				t.Errorf("%s: unexpected column norms: got %v, want %v", name, got, want)
+			}
+		}
+	}
+}
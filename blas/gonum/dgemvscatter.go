@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvScatter computes
+//  z = alpha * A * x + beta * z   if tA = blas.NoTrans
+//  z = alpha * Aᵀ * x + beta * z  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x is a vector, alpha and beta are scalars,
+// and element i of the vector z is stored in y[yIdx[i]].
+//
+// yIdx must have at least m elements if tA == blas.NoTrans and n otherwise,
+// and those elements must be distinct indices into y. Elements of y that are
+// not indexed are not referenced, so the result can be scattered into the
+// positions of a larger or sparse vector. DgemvScatter panics if an index is
+// out of range.
+func (Implementation) DgemvScatter(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, yIdx []int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if len(yIdx) < lenY {
+		panic(shortYIdx)
+	}
+	yIdx = yIdx[:lenY]
+	for _, iy := range yIdx {
+		if iy < 0 || len(y) <= iy {
+			panic(badYIdx)
+		}
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+
+	// Form z = beta * z.
+	if beta != 1 {
+		if beta == 0 {
+			for _, iy := range yIdx {
+				y[iy] = 0
+			}
+		} else {
+			for _, iy := range yIdx {
+				y[iy] *= beta
+			}
+		}
+	}
+
+	if alpha == 0 {
+		return
+	}
+
+	if tA == blas.NoTrans {
+		// Form z = alpha * A * x + z.
+		for i, iy := range yIdx {
+			var dot float64
+			if incX == 1 {
+				dot = f64.DotUnitary(a[i*lda:i*lda+n], x[:n])
+			} else {
+				dot = f64.DotInc(a[i*lda:i*lda+n], x, uintptr(n), 1, uintptr(incX), 0, uintptr(kx))
+			}
+			y[iy] += alpha * dot
+		}
+		return
+	}
+	// Form z = alpha * Aᵀ * x + z.
+	ix := kx
+	for i := 0; i < m; i++ {
+		tmp := alpha * x[ix]
+		for j, v := range a[i*lda : i*lda+n] {
+			y[yIdx[j]] += tmp * v
+		}
+		ix += incX
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvScatter(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 4}, {7, 2}, {10, 10}} {
+			for _, incX := range []int{1, -2} {
+				for _, ab := range []struct{ alpha, beta float64 }{{1, 0}, {-0.5, 2}, {0, 0.5}, {2, 1}} {
+					m, n := mn.m, mn.n
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					a := randomDense(rnd, m, n, n+1)
+					x := randvec(lenX, incX, rnd)
+					for _, test := range []struct {
+						kind string
+						idx  []int
+						size int
+					}{
+						{kind: "permutation", idx: rnd.Perm(lenY), size: lenY},
+						{kind: "gaps", idx: gapIndices(rnd, lenY), size: 3*lenY + 2},
+					} {
+						name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,alpha=%v,beta=%v,%s", tA, m, n, incX, ab.alpha, ab.beta, test.kind)
+
+						// Positions of y that are not indexed hold NaN and
+						// must not be modified.
+						y := nanSlice(test.size)
+						want := make([]float64, lenY)
+						for i, iy := range test.idx {
+							y[iy] = rnd.NormFloat64()
+							want[i] = y[iy]
+						}
+						impl.Dgemv(tA, m, n, ab.alpha, a, n+1, x, incX, ab.beta, want, 1)
+
+						impl.DgemvScatter(tA, m, n, ab.alpha, a, n+1, x, incX, ab.beta, y, test.idx)
+						got := make([]float64, lenY)
+						isIndexed := make([]bool, len(y))
+						for i, iy := range test.idx {
+							got[i] = y[iy]
+							isIndexed[iy] = true
+						}
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%s: gathered result differs from Dgemv: got %v, want %v", name, got, want)
+						}
+						for i, v := range y {
+							if !isIndexed[i] && !math.IsNaN(v) {
+								t.Errorf("%s: element %d of y not in yIdx was modified", name, i)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// gapIndices returns n distinct increasing indices into a slice of length
+// 3*n+2 with gaps of random length between them.
+func gapIndices(rnd *rand.Rand, n int) []int {
+	idx := make([]int, n)
+	next := rnd.Intn(3)
+	for i := range idx {
+		idx[i] = next
+		next += 1 + rnd.Intn(3)
+	}
+	return idx
+}
+
+func TestDgemvScatterPanics(t *testing.T) {
+	a := []float64{1, 2, 3, 4}
+	x := []float64{1, 1}
+	y := make([]float64, 3)
+	for _, test := range []struct {
+		idx  []int
+		want string
+	}{
+		{idx: []int{0}, want: shortYIdx},
+		{idx: []int{0, 3}, want: badYIdx},
+		{idx: []int{-1, 2}, want: badYIdx},
+	} {
+		got := panicValue(func() { impl.DgemvScatter(blas.NoTrans, 2, 2, 1, a, 2, x, 1, 0, y, test.idx) })
+		if got != test.want {
+			t.Errorf("yIdx=%v: unexpected panic: got %v, want %q", test.idx, got, test.want)
+		}
+	}
+}
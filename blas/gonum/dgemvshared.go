@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvShared computes for k = 0, ..., p-1
+//  y_k = alphas[k] * A_k * x + betas[k] * y_k   if tA = blas.NoTrans
+//  y_k = alphas[k] * A_kᵀ * x + betas[k] * y_k  if tA = blas.Trans or blas.ConjTrans
+// where the A_k are the p = len(matrices) m×n dense matrices stored in
+// matrices[k] with the common leading dimension lda, x is a vector shared by
+// all products, and y_k is row k of the p×m (tA = blas.NoTrans) or p×n
+// (otherwise) dense matrix Y stored in y with leading dimension ldy.
+//
+// If incX is not 1, x is gathered into a contiguous temporary once, so every
+// product reads x with unit stride from the same cache-resident slice. The
+// result for each k is the same as that of Dgemv with incY = 1 in a build
+// without the gemvunroll tag.
+func (impl Implementation) DgemvShared(tA blas.Transpose, m, n int, alphas []float64, matrices [][]float64, lda int, x []float64, incX int, betas []float64, y []float64, ldy int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if ldy < max(1, lenY) {
+		panic(badLdY)
+	}
+	p := len(matrices)
+	if len(alphas) < p {
+		panic(shortAlphas)
+	}
+	if len(betas) < p {
+		panic(shortBetas)
+	}
+
+	// Quick return if possible.
+	if p == 0 || m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	for _, a := range matrices {
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if len(y) < ldy*(p-1)+lenY {
+		panic(shortY)
+	}
+
+	if incX != 1 {
+		tmp := make([]float64, lenX)
+		impl.Dcopy(lenX, x, incX, tmp, 1)
+		x = tmp
+	}
+	x = x[:lenX]
+	for k, a := range matrices {
+		alpha, beta := alphas[k], betas[k]
+		yk := y[k*ldy : k*ldy+lenY]
+		if alpha == 0 {
+			dscalePrepY(lenY, beta, yk, 1)
+			continue
+		}
+		if tA == blas.NoTrans {
+			f64.GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, 1, beta, yk, 1)
+		} else {
+			f64.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, 1, beta, yk, 1)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvShared(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {4, 1}, {1, 4}, {5, 5}, {7, 3}, {3, 8}} {
+			m, n := mn[0], mn[1]
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			for _, p := range []int{0, 1, 4} {
+				for _, lda := range []int{max(1, n), n + 2} {
+					for _, ldy := range []int{max(1, lenY), lenY + 3} {
+						for _, incX := range []int{1, 3, -2} {
+							name := fmt.Sprintf("tA=%c,m=%v,n=%v,p=%v,lda=%v,ldy=%v,incX=%v", tA, m, n, p, lda, ldy, incX)
+							matrices := make([][]float64, p)
+							for k := range matrices {
+								matrices[k] = randvec(max(0, lda*(m-1)+n), 1, rnd)
+							}
+							alphas := make([]float64, p)
+							betas := make([]float64, p)
+							for k := range alphas {
+								// Include the special values of alpha and beta.
+								alphas[k] = []float64{0, 1, -2.5, 0.5}[k%4]
+								betas[k] = []float64{0.5, 0, 1, 2}[k%4]
+							}
+							x := randvec(lenX, incX, rnd)
+							y := randvec(max(0, ldy*(p-1)+lenY), 1, rnd)
+
+							want := make([]float64, len(y))
+							copy(want, y)
+							if lenY > 0 {
+								for k, a := range matrices {
+									impl.Dgemv(tA, m, n, alphas[k], a, lda, x, incX, betas[k], want[k*ldy:], 1)
+								}
+							}
+
+							got := make([]float64, len(y))
+							copy(got, y)
+							impl.DgemvShared(tA, m, n, alphas, matrices, lda, x, incX, betas, got, ldy)
+
+							if !floats.EqualApprox(got, want, tol) {
+								t.Errorf("%v: result differs from separate Dgemv calls\nwant %v\ngot  %v", name, want, got)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvSharedPanics(t *testing.T) {
+	a := make([]float64, 6)
+	matrices := [][]float64{a, a}
+	x := make([]float64, 3)
+	y := make([]float64, 4)
+	one := []float64{1, 1}
+	if !panics(func() { impl.DgemvShared(blas.NoTrans, 2, 3, one[:1], matrices, 3, x, 1, one, y, 2) }) {
+		t.Errorf("expected panic for short alphas")
+	}
+	if !panics(func() { impl.DgemvShared(blas.NoTrans, 2, 3, one, matrices, 3, x, 1, one[:1], y, 2) }) {
+		t.Errorf("expected panic for short betas")
+	}
+	if !panics(func() { impl.DgemvShared(blas.NoTrans, 2, 3, one, matrices, 3, x, 1, one, y, 1) }) {
+		t.Errorf("expected panic for bad ldy")
+	}
+	if !panics(func() { impl.DgemvShared(blas.NoTrans, 2, 3, one, [][]float64{a, a[:5]}, 3, x, 1, one, y, 2) }) {
+		t.Errorf("expected panic for short matrix")
+	}
+	if !panics(func() { impl.DgemvShared(blas.NoTrans, 2, 3, one, matrices, 3, x, 1, one, y[:3], 2) }) {
+		t.Errorf("expected panic for short y")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DgemvSoftmax computes
+//  y = softmax(A * x)   if tA = blas.NoTrans
+//  y = softmax(Aᵀ * x)  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x and y are vectors, and
+//  softmax(z)[i] = exp(z[i]) / sum_j exp(z[j]).
+// The input values of y are not used.
+//
+// The product is formed in y by Dgemv, and the softmax is applied in place.
+// The largest element of the product is subtracted before exponentiation, so
+// the result does not overflow for large inputs, and the largest element of
+// y is at least 1/len(y). Elements of the product that are -Inf give zero,
+// while NaN or +Inf in the product makes all of y NaN. If A has no columns in
+// the product, every element of y is set to 1/len(y).
+func (impl Implementation) DgemvSoftmax(tA blas.Transpose, m, n int, a []float64, lda int, x []float64, incX int, y []float64, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+
+	// Quick return if possible.
+	if lenY == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if lenX > 0 {
+		if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+			panic(shortX)
+		}
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+	}
+
+	var ky int
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// Form y = op(A) * x. Dgemv does not reference y if op(A) has no
+	// columns, so the empty product is set here.
+	if lenX == 0 {
+		dscalePrepY(lenY, 0, y, incY)
+	} else {
+		impl.Dgemv(tA, m, n, 1, a, lda, x, incX, 0, y, incY)
+	}
+
+	// Form y = exp(y - max(y)) / sum(exp(y - max(y))).
+	zMax := math.Inf(-1)
+	iy := ky
+	for i := 0; i < lenY; i++ {
+		v := y[iy]
+		if math.IsNaN(v) {
+			zMax = v
+			break
+		}
+		if v > zMax {
+			zMax = v
+		}
+		iy += incY
+	}
+	var sum float64
+	iy = ky
+	for i := 0; i < lenY; i++ {
+		e := math.Exp(y[iy] - zMax)
+		y[iy] = e
+		sum += e
+		iy += incY
+	}
+	iy = ky
+	for i := 0; i < lenY; i++ {
+		y[iy] /= sum
+		iy += incY
+	}
+}
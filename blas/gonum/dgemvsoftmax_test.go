@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvSoftmax(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}, {20, 20}} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, -3}} {
+				// A scale of 1000 makes the elements of the product large
+				// enough that exp overflows without the max-subtraction.
+				for _, scale := range []float64{1, 1000} {
+					m, n := mn.m, mn.n
+					name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,incY=%d,scale=%v", tA, m, n, inc.x, inc.y, scale)
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					lda := n + 1
+					a := randomDense(rnd, m, n, lda)
+					for i := range a {
+						a[i] *= scale
+					}
+					x := randvec(lenX, inc.x, rnd)
+
+					// Compute the product with Dgemv and apply a reference
+					// softmax.
+					z := make([]float64, lenY)
+					impl.Dgemv(tA, m, n, 1, a, lda, x, inc.x, 0, z, 1)
+					want := softmax(z)
+
+					y := nanSlice(1 + (lenY-1)*abs(inc.y))
+					impl.DgemvSoftmax(tA, m, n, a, lda, x, inc.x, y, inc.y)
+					got := make([]float64, lenY)
+					var sum float64
+					for i := range got {
+						got[i] = y[vecIndex(i, lenY, inc.y)]
+						sum += got[i]
+					}
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%s: unexpected result: got %v, want %v", name, got, want)
+					}
+					if math.Abs(sum-1) > tol*float64(lenY) {
+						t.Errorf("%s: result sums to %v, want 1", name, sum)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvSoftmaxSpecial(t *testing.T) {
+	// Without subtracting the maximum, exp(1000) overflows.
+	a := []float64{1000, 999, 0}
+	y := make([]float64, 3)
+	impl.DgemvSoftmax(blas.NoTrans, 3, 1, a, 1, []float64{1}, 1, y, 1)
+	e := math.Exp(-1)
+	want := []float64{1 / (1 + e + math.Exp(-1000)), e / (1 + e + math.Exp(-1000)), 0}
+	if !floats.EqualApprox(y, want, 1e-15) {
+		t.Errorf("unexpected result for large inputs: got %v, want %v", y, want)
+	}
+
+	// An empty product gives the uniform distribution.
+	y = []float64{math.NaN(), math.NaN(), math.NaN()}
+	impl.DgemvSoftmax(blas.NoTrans, 3, 0, nil, 1, nil, 1, y, 1)
+	if !floats.Equal(y, []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}) {
+		t.Errorf("unexpected result for an empty product: got %v", y)
+	}
+
+	// NaN propagates to all elements.
+	y = make([]float64, 3)
+	impl.DgemvSoftmax(blas.NoTrans, 3, 1, []float64{1, math.NaN(), 2}, 1, []float64{1}, 1, y, 1)
+	for i, v := range y {
+		if !math.IsNaN(v) {
+			t.Errorf("unexpected y[%d] for NaN input: got %v, want NaN", i, v)
+		}
+	}
+}
+
+// softmax returns exp(z[i] - max(z)) / sum_j exp(z[j] - max(z)).
+func softmax(z []float64) []float64 {
+	zMax := floats.Max(z)
+	s := make([]float64, len(z))
+	var sum float64
+	for i, v := range z {
+		s[i] = math.Exp(v - zMax)
+		sum += s[i]
+	}
+	floats.Scale(1/sum, s)
+	return s
+}
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DgemvStats computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x and y are vectors, and alpha and beta are
+// scalars, and returns the mean and the population variance of the elements
+// of the result y.
+//
+// Each element of y is folded into Welford's running mean and sum of squared
+// deviations as soon as it is final, so y is not traversed a second time. The
+// running form does not lose precision when the mean is large compared to the
+// spread. For tA == blas.NoTrans an element is final after the dot product of
+// its row of A with x, and otherwise after the closing pass that scales the
+// accumulated Aᵀ * x by alpha and adds beta * y. The result agrees with that
+// of Dgemv up to rounding. As in Dgemv, y is not modified if m or n is zero,
+// and the statistics are those of the input y. If y has no elements, mean and
+// variance are NaN.
+func (impl Implementation) DgemvStats(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) (mean, variance float64) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+	lenX, lenY := n, m
+	if tA != blas.NoTrans {
+		lenX, lenY = m, n
+	}
+
+	// Quick return if possible.
+	if lenY == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if m > 0 && n > 0 {
+		if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+			panic(shortX)
+		}
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// add folds v into the running mean and sum of squared deviations m2
+	// of the first k elements.
+	var m2 float64
+	var k int
+	add := func(v float64) {
+		k++
+		d := v - mean
+		mean += d / float64(k)
+		m2 += d * (v - mean)
+	}
+
+	if m == 0 || n == 0 {
+		iy := ky
+		for i := 0; i < lenY; i++ {
+			add(y[iy])
+			iy += incY
+		}
+		return mean, m2 / float64(lenY)
+	}
+
+	if alpha == 0 {
+		// A is not referenced, as in Dgemv.
+		iy := ky
+		for i := 0; i < lenY; i++ {
+			var v float64
+			if beta != 0 {
+				v = beta * y[iy]
+			}
+			y[iy] = v
+			add(v)
+			iy += incY
+		}
+		return mean, m2 / float64(lenY)
+	}
+
+	impl.Counter.add(m * n)
+
+	if &x[0] == &y[0] {
+		// x and y alias, so copy x to a temporary to keep it from
+		// being overwritten before it has been completely read.
+		tmp := make([]float64, lenX)
+		impl.Dcopy(lenX, x, incX, tmp, 1)
+		x = tmp
+		incX = 1
+		kx = 0
+	}
+
+	if tA == blas.NoTrans {
+		iy := ky
+		for i := 0; i < m; i++ {
+			var dot float64
+			if incX == 1 {
+				dot = f64.DotUnitary(a[i*lda:i*lda+n], x[:n])
+			} else {
+				dot = f64.DotInc(a[i*lda:i*lda+n], x, uintptr(n), 1, uintptr(incX), 0, uintptr(kx))
+			}
+			v := alpha * dot
+			if beta != 0 {
+				v += beta * y[iy]
+			}
+			y[iy] = v
+			add(v)
+			iy += incY
+		}
+		return mean, m2 / float64(lenY)
+	}
+
+	// Accumulate Aᵀ * x row by row of A, then form and fold each element of y.
+	t := make([]float64, n)
+	ix := kx
+	for i := 0; i < m; i++ {
+		f64.AxpyUnitary(x[ix], a[i*lda:i*lda+n], t)
+		ix += incX
+	}
+	iy := ky
+	for _, tj := range t {
+		v := alpha * tj
+		if beta != 0 {
+			v += beta * y[iy]
+		}
+		y[iy] = v
+		add(v)
+		iy += incY
+	}
+	return mean, m2 / float64(lenY)
+}
@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvStats(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}, {20, 20}} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, -3}} {
+				for _, ab := range []struct{ alpha, beta float64 }{{1, 0}, {0.5, 2}, {0, -1}} {
+					// An offset of 1e8 makes the mean large compared to the
+					// spread of the elements.
+					for _, offset := range []float64{0, 1e8} {
+						m, n := mn.m, mn.n
+						name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,incY=%d,alpha=%v,beta=%v,offset=%v",
+							tA, m, n, inc.x, inc.y, ab.alpha, ab.beta, offset)
+						lenX, lenY := n, m
+						if tA != blas.NoTrans {
+							lenX, lenY = m, n
+						}
+						lda := n + 1
+						a := randomDense(rnd, m, n, lda)
+						x := randvec(lenX, inc.x, rnd)
+						y := randvec(lenY, inc.y, rnd)
+						for i := 0; i < lenY; i++ {
+							y[vecIndex(i, lenY, inc.y)] += offset
+						}
+
+						want := make([]float64, len(y))
+						copy(want, y)
+						impl.Dgemv(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, want, inc.y)
+
+						got := make([]float64, len(y))
+						copy(got, y)
+						mean, variance := impl.DgemvStats(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, got, inc.y)
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%s: result differs from Dgemv", name)
+						}
+
+						// Two-pass reference statistics of the Dgemv result.
+						var wantMean float64
+						for i := 0; i < lenY; i++ {
+							wantMean += want[vecIndex(i, lenY, inc.y)]
+						}
+						wantMean /= float64(lenY)
+						var wantVar float64
+						for i := 0; i < lenY; i++ {
+							d := want[vecIndex(i, lenY, inc.y)] - wantMean
+							wantVar += d * d
+						}
+						wantVar /= float64(lenY)
+
+						if !floats.EqualWithinAbsOrRel(mean, wantMean, tol, tol) {
+							t.Errorf("%s: unexpected mean: got %v, want %v", name, mean, wantMean)
+						}
+						// Rounding of elements of magnitude offset limits
+						// the accuracy of both variance computations.
+						varTol := tol + 1e-15*offset
+						if !floats.EqualWithinAbsOrRel(variance, wantVar, varTol, varTol) {
+							t.Errorf("%s: unexpected variance: got %v, want %v", name, variance, wantVar)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvStatsEmpty(t *testing.T) {
+	mean, variance := impl.DgemvStats(blas.NoTrans, 0, 3, 1, nil, 3, []float64{1, 2, 3}, 1, 0, nil, 1)
+	if !math.IsNaN(mean) || !math.IsNaN(variance) {
+		t.Errorf("unexpected statistics for empty y: got %v, %v, want NaN, NaN", mean, variance)
+	}
+
+	// With no columns in the product y is not modified, and the statistics
+	// are those of the input.
+	y := []float64{1, 2, 3, 6}
+	mean, variance = impl.DgemvStats(blas.NoTrans, 4, 0, 1, nil, 1, nil, 1, 0, y, 1)
+	if !floats.Equal(y, []float64{1, 2, 3, 6}) {
+		t.Errorf("y modified for empty product: got %v", y)
+	}
+	if mean != 3 || variance != 3.5 {
+		t.Errorf("unexpected statistics for empty product: got %v, %v, want 3, 3.5", mean, variance)
+	}
+}
+
+func TestDgemvStatsAliasAndZeroAlpha(t *testing.T) {
+	const tol = 1e-14
+
+	// With alpha zero A is not referenced.
+	y := []float64{1, 2, 3}
+	mean, variance := impl.DgemvStats(blas.NoTrans, 3, 2, 0, nanSlice(6), 2, []float64{1, 1}, 1, 2, y, 1)
+	if !floats.Equal(y, []float64{2, 4, 6}) {
+		t.Errorf("unexpected y for alpha zero: got %v, want [2 4 6]", y)
+	}
+	if !floats.EqualWithinAbsOrRel(mean, 4, tol, tol) || !floats.EqualWithinAbsOrRel(variance, 8.0/3, tol, tol) {
+		t.Errorf("unexpected statistics for alpha zero: got %v, %v, want 4, %v", mean, variance, 8.0/3)
+	}
+
+	// x and y may be the same vector.
+	a := []float64{
+		1, 2,
+		3, 4,
+	}
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		want := []float64{1, 2}
+		impl.Dgemv(tA, 2, 2, 1, a, 2, []float64{1, 2}, 1, 1, want, 1)
+		xy := []float64{1, 2}
+		impl.DgemvStats(tA, 2, 2, 1, a, 2, xy, 1, 1, xy, 1)
+		if !floats.Equal(xy, want) {
+			t.Errorf("tA=%c: unexpected result for aliased x and y: got %v, want %v", tA, xy, want)
+		}
+	}
+}
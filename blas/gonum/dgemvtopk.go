@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"container/heap"
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DgemvTopK computes the elements of
+//  z = A * x   if tA = blas.NoTrans
+//  z = Aᵀ * x  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix and x is a vector, and returns the indices
+// and values of the k largest elements of z in descending order of value.
+// Elements with equal values are ordered by increasing index, and of several
+// equal values at the boundary the ones with the lowest indices are kept. NaN
+// elements are ignored as in DgemvArgmax, so fewer than k elements are
+// returned if z has fewer than k elements that are not NaN.
+//
+// Each element of z is computed as a dot product and kept in a min-heap of
+// the k largest elements seen so far, so z is never stored and only O(k)
+// memory is used. The cost of the selection is O(len(z) * log(k)).
+func (Implementation) DgemvTopK(tA blas.Transpose, m, n int, a []float64, lda int, x []float64, incX int, k int) (indices []int, values []float64) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+
+	// Quick return if possible.
+	if lenY == 0 || k == 0 {
+		return nil, nil
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if lenX > 0 {
+		if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+			panic(shortX)
+		}
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+
+	h := make(topKHeap, 0, min(k, lenY))
+	for i := 0; i < lenY; i++ {
+		// Every element of an empty product is zero.
+		var v float64
+		if lenX > 0 {
+			v = dgemvElem(tA, i, m, n, a, lda, x, incX, kx)
+		}
+		if math.IsNaN(v) {
+			continue
+		}
+		e := topKElem{idx: i, val: v}
+		if len(h) < k {
+			heap.Push(&h, e)
+			continue
+		}
+		// Elements arrive in increasing index order, so an element equal
+		// to the smallest kept value does not replace it.
+		if v > h[0].val {
+			h[0] = e
+			heap.Fix(&h, 0)
+		}
+	}
+
+	indices = make([]int, len(h))
+	values = make([]float64, len(h))
+	for i := len(h) - 1; i >= 0; i-- {
+		e := heap.Pop(&h).(topKElem)
+		indices[i] = e.idx
+		values[i] = e.val
+	}
+	return indices, values
+}
+
+// topKElem is an element of the product kept by DgemvTopK.
+type topKElem struct {
+	idx int
+	val float64
+}
+
+// topKHeap is a min-heap of the elements kept by DgemvTopK. The minimum is
+// the element with the smallest value and, of equal values, the largest
+// index, which is the first element to be displaced.
+type topKHeap []topKElem
+
+func (h topKHeap) Len() int { return len(h) }
+func (h topKHeap) Less(i, j int) bool {
+	return h[i].val < h[j].val || (h[i].val == h[j].val && h[i].idx > h[j].idx)
+}
+func (h topKHeap) Swap(i, j int)         { h[i], h[j] = h[j], h[i] }
+func (h *topKHeap) Push(x interface{})   { *h = append(*h, x.(topKElem)) }
+func (h *topKHeap) Pop() (x interface{}) { x, *h = (*h)[len(*h)-1], (*h)[:len(*h)-1]; return x }
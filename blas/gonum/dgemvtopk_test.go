@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvTopK(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}, {20, 20}, {100, 7}} {
+			for _, incX := range []int{1, -3} {
+				for _, k := range []int{1, 2, 5, 100, 200} {
+					m, n := mn.m, mn.n
+					name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,k=%d", tA, m, n, incX, k)
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					lda := n + 1
+					a := randomDense(rnd, m, n, lda)
+					x := randvec(lenX, incX, rnd)
+
+					// Compute the full product with Dgemv and sort it.
+					z := make([]float64, lenY)
+					impl.Dgemv(tA, m, n, 1, a, lda, x, incX, 0, z, 1)
+					want := make([]int, lenY)
+					for i := range want {
+						want[i] = i
+					}
+					sort.SliceStable(want, func(i, j int) bool { return z[want[i]] > z[want[j]] })
+					want = want[:min(k, lenY)]
+
+					indices, values := impl.DgemvTopK(tA, m, n, a, lda, x, incX, k)
+					if !reflect.DeepEqual(indices, want) {
+						t.Errorf("%s: unexpected indices: got %v, want %v", name, indices, want)
+						continue
+					}
+					for i, idx := range indices {
+						if !floats.EqualWithinAbsOrRel(values[i], z[idx], tol, tol) {
+							t.Errorf("%s: unexpected value %d: got %v, want %v", name, i, values[i], z[idx])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvTopKSpecial(t *testing.T) {
+	nan := math.NaN()
+	for _, test := range []struct {
+		name        string
+		a           []float64
+		k           int
+		wantIndices []int
+		wantValues  []float64
+	}{
+		{name: "ties", a: []float64{1, 3, 2, 3, 3}, k: 2, wantIndices: []int{1, 3}, wantValues: []float64{3, 3}},
+		{name: "ties below", a: []float64{2, 1, 1, 1}, k: 3, wantIndices: []int{0, 1, 2}, wantValues: []float64{2, 1, 1}},
+		{name: "NaN", a: []float64{1, nan, 2, nan}, k: 3, wantIndices: []int{2, 0}, wantValues: []float64{2, 1}},
+		{name: "Inf", a: []float64{math.Inf(-1), 0, math.Inf(1)}, k: 3, wantIndices: []int{2, 1, 0}, wantValues: []float64{math.Inf(1), 0, math.Inf(-1)}},
+		{name: "all NaN", a: []float64{nan, nan}, k: 1, wantIndices: []int{}, wantValues: []float64{}},
+	} {
+		// A is a column, so z = A * 1 is the column itself.
+		m := len(test.a)
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			var indices []int
+			var values []float64
+			if tA == blas.NoTrans {
+				indices, values = impl.DgemvTopK(tA, m, 1, test.a, 1, []float64{1}, 1, test.k)
+			} else {
+				indices, values = impl.DgemvTopK(tA, 1, m, test.a, m, []float64{1}, 1, test.k)
+			}
+			if !reflect.DeepEqual(indices, test.wantIndices) || !floats.Same(values, test.wantValues) {
+				t.Errorf("%s,tA=%c: unexpected result: got (%v, %v), want (%v, %v)",
+					test.name, tA, indices, values, test.wantIndices, test.wantValues)
+			}
+		}
+	}
+
+	indices, values := impl.DgemvTopK(blas.NoTrans, 0, 3, nil, 3, nil, 1, 2)
+	if indices != nil || values != nil {
+		t.Errorf("unexpected result for empty product: got (%v, %v)", indices, values)
+	}
+	indices, values = impl.DgemvTopK(blas.NoTrans, 3, 0, nil, 1, nil, 1, 2)
+	if !reflect.DeepEqual(indices, []int{0, 1}) || !floats.Same(values, []float64{0, 0}) {
+		t.Errorf("unexpected result for product with no columns: got (%v, %v), want ([0 1], [0 0])", indices, values)
+	}
+	if !panics(func() { impl.DgemvTopK(blas.NoTrans, 1, 1, []float64{1}, 1, []float64{1}, 1, -1) }) {
+		t.Error("no panic for k < 0")
+	}
+}
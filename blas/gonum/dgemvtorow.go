@@ -0,0 +1,42 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DgemvToRow computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x is a vector, y is the row rowIndex of the
+// dense matrix Y with leading dimension ldY, and alpha and beta are scalars.
+//
+// The row of Y has m elements if tA == blas.NoTrans and n elements otherwise,
+// and Y must have at least that many columns. DgemvToRow returns the same
+// result as calling Dgemv with a temporary y and copying it into the row.
+func (impl Implementation) DgemvToRow(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, ldY, rowIndex int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	lenY := n
+	if tA == blas.NoTrans {
+		lenY = m
+	}
+	if ldY < max(1, lenY) {
+		panic(badLdY)
+	}
+	if rowIndex < 0 {
+		panic(rowIndexLT0)
+	}
+	if m == 0 || n == 0 {
+		// Dgemv checks the remaining arguments and returns without
+		// referencing y.
+		impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, nil, 1)
+		return
+	}
+	if len(y) < rowIndex*ldY+lenY {
+		panic(shortY)
+	}
+	impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, y[rowIndex*ldY:rowIndex*ldY+lenY], 1)
+}
@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgemvToRow(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{0, 3}, {3, 0}, {1, 1}, {2, 5}, {5, 2}, {7, 7}} {
+			m, n := mn[0], mn[1]
+			lenY := n
+			if tA == blas.NoTrans {
+				lenY = m
+			}
+			for _, incX := range []int{1, 2, -3} {
+				for _, ldY := range []int{max(1, lenY), lenY + 3} {
+					for _, rowIndex := range []int{0, 2} {
+						lenX := m
+						if tA == blas.NoTrans {
+							lenX = n
+						}
+						a := randmat(m, n, n+1, rnd)
+						x := randvec(lenX, incX, rnd)
+						yMat := randmat(3, ldY, ldY, rnd)
+
+						// Compute the reference result into a buffer and
+						// copy it into the row of Y.
+						want := make([]float64, len(yMat))
+						copy(want, yMat)
+						buf := make([]float64, lenY)
+						copy(buf, yMat[rowIndex*ldY:])
+						impl.Dgemv(tA, m, n, 1.5, a, n+1, x, incX, -0.5, buf, 1)
+						copy(want[rowIndex*ldY:], buf)
+
+						got := make([]float64, len(yMat))
+						copy(got, yMat)
+						impl.DgemvToRow(tA, m, n, 1.5, a, n+1, x, incX, -0.5, got, ldY, rowIndex)
+
+						prefix := fmt.Sprintf("tA=%v,m=%v,n=%v,incX=%v,ldY=%v,rowIndex=%v", tA, m, n, incX, ldY, rowIndex)
+						for i := range want {
+							if got[i] != want[i] {
+								t.Errorf("%v: unexpected Y\nwant %v\ngot  %v", prefix, want, got)
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
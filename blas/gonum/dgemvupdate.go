@@ -0,0 +1,19 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DgemvUpdate performs the gradient descent step
+//  w -= lr * A * x   if tA = blas.NoTrans
+//  w -= lr * Aᵀ * x  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x and w are vectors, and lr is the learning
+// rate.
+//
+// DgemvUpdate is Dgemv called with alpha = -lr and beta = 1, and has the same
+// argument checks and result.
+func (impl Implementation) DgemvUpdate(tA blas.Transpose, m, n int, lr float64, a []float64, lda int, x []float64, incX int, w []float64, incW int) {
+	impl.Dgemv(tA, m, n, -lr, a, lda, x, incX, 1, w, incW)
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvUpdate(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}} {
+			for _, inc := range []struct{ x, w int }{{1, 1}, {2, -3}} {
+				for _, lr := range []float64{0, 0.01, -2} {
+					m, n := mn.m, mn.n
+					name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,incW=%d,lr=%v", tA, m, n, inc.x, inc.w, lr)
+					lenX, lenW := n, m
+					if tA != blas.NoTrans {
+						lenX, lenW = m, n
+					}
+					a := randomDense(rnd, m, n, n+1)
+					x := randvec(lenX, inc.x, rnd)
+					w := randvec(lenW, inc.w, rnd)
+
+					want := make([]float64, len(w))
+					copy(want, w)
+					impl.Dgemv(tA, m, n, -lr, a, n+1, x, inc.x, 1, want, inc.w)
+
+					got := make([]float64, len(w))
+					copy(got, w)
+					impl.DgemvUpdate(tA, m, n, lr, a, n+1, x, inc.x, got, inc.w)
+					if !floats.Same(got, want) {
+						t.Errorf("%s: result differs from Dgemv: got %v, want %v", name, got, want)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// TestDgerSelfOuter checks that Dger with the same slice passed as x and y
+// forms the symmetric matrix A + alpha * x * xᵀ computed by Dsyr.
+func TestDgerSelfOuter(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 8, 17} {
+		for _, inc := range []int{1, 2, -1, -3} {
+			for _, alpha := range []float64{1, -0.7} {
+				name := fmt.Sprintf("n=%d,inc=%d,alpha=%v", n, inc, alpha)
+				lda := n + 1
+				x := randvec(n, inc, rnd)
+				// Zero elements of x exercise the rows skipped by Dsyr.
+				x[vecIndex(n/2, n, inc)] = 0
+
+				// Start from a symmetric matrix so that the result is
+				// symmetric as a whole.
+				a := nanSlice(n * lda)
+				for i := 0; i < n; i++ {
+					for j := i; j < n; j++ {
+						v := rnd.NormFloat64()
+						a[i*lda+j] = v
+						a[j*lda+i] = v
+					}
+				}
+
+				got := make([]float64, len(a))
+				copy(got, a)
+				impl.Dger(n, n, alpha, x, inc, x, inc, got, lda)
+
+				upper := make([]float64, len(a))
+				copy(upper, a)
+				impl.Dsyr(blas.Upper, n, alpha, x, inc, upper, lda)
+				lower := make([]float64, len(a))
+				copy(lower, a)
+				impl.Dsyr(blas.Lower, n, alpha, x, inc, lower, lda)
+
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						want := upper[i*lda+j]
+						if j < i {
+							want = lower[i*lda+j]
+						}
+						if !floats.EqualWithinAbsOrRel(got[i*lda+j], want, tol, tol) {
+							t.Errorf("%s: unexpected A[%d][%d]: got %v, want %v", name, i, j, got[i*lda+j], want)
+						}
+						if !floats.EqualWithinAbsOrRel(got[i*lda+j], got[j*lda+i], tol, tol) {
+							t.Errorf("%s: result not symmetric at A[%d][%d]", name, i, j)
+						}
+					}
+				}
+				for i := 0; i < n; i++ {
+					if !floats.Same(got[i*lda+n:i*lda+lda], a[i*lda+n:i*lda+lda]) {
+						t.Errorf("%s: element outside A modified in row %d", name, i)
+					}
+				}
+			}
+		}
+	}
+}
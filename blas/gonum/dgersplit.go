@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/internal/asm/f64"
+
+// DgerSplit performs the complex rank-one operation
+//  A += alpha * x * yᵀ
+// where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar,
+// with the real and imaginary parts of A, x, y and alpha stored separately.
+//
+// The real and imaginary parts of the complex element (i, j) of A are stored
+// in aRe[i*lda+j] and aIm[i*lda+j], and those of the complex element i of x
+// are stored in xRe and xIm with increment incX. DgerSplit returns the same
+// result as Zgeru called with the equivalent complex128 slices.
+func (Implementation) DgerSplit(m, n int, alphaRe, alphaIm float64, xRe, xIm []float64, incX int, yRe, yIm []float64, incY int, aRe, aIm []float64, lda int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	for _, x := range [][]float64{xRe, xIm} {
+		if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+			panic(shortX)
+		}
+	}
+	for _, y := range [][]float64{yRe, yIm} {
+		if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+			panic(shortY)
+		}
+	}
+	if len(aRe) < lda*(m-1)+n || len(aIm) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alphaRe == 0 && alphaIm == 0 {
+		return
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = -(m - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(n - 1) * incY
+	}
+	ix := kx
+	for i := 0; i < m; i++ {
+		// Form tmp = alpha * x[i] and update the real and imaginary
+		// planes of row i of A with the real kernels.
+		tmpRe := alphaRe*xRe[ix] - alphaIm*xIm[ix]
+		tmpIm := alphaRe*xIm[ix] + alphaIm*xRe[ix]
+		aReRow := aRe[i*lda : i*lda+n]
+		aImRow := aIm[i*lda : i*lda+n]
+		if incY == 1 {
+			f64.AxpyUnitary(tmpRe, yRe[:n], aReRow)
+			f64.AxpyUnitary(-tmpIm, yIm[:n], aReRow)
+			f64.AxpyUnitary(tmpRe, yIm[:n], aImRow)
+			f64.AxpyUnitary(tmpIm, yRe[:n], aImRow)
+		} else {
+			f64.AxpyInc(tmpRe, yRe, aReRow, uintptr(n), uintptr(incY), 1, uintptr(ky), 0)
+			f64.AxpyInc(-tmpIm, yIm, aReRow, uintptr(n), uintptr(incY), 1, uintptr(ky), 0)
+			f64.AxpyInc(tmpRe, yIm, aImRow, uintptr(n), uintptr(incY), 1, uintptr(ky), 0)
+			f64.AxpyInc(tmpIm, yRe, aImRow, uintptr(n), uintptr(incY), 1, uintptr(ky), 0)
+		}
+		ix += incX
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDgerSplit(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range [][2]int{{1, 1}, {1, 4}, {3, 1}, {4, 5}, {7, 3}} {
+		m, n := mn[0], mn[1]
+		for _, inc := range [][2]int{{1, 1}, {2, 3}, {-3, 2}, {-2, -4}} {
+			incX, incY := inc[0], inc[1]
+			for _, lda := range []int{n, n + 3} {
+				for _, alpha := range []complex128{0, 1, 2 - 1i, 0.5i} {
+					xRe, xIm := randvec(m, incX, rnd), randvec(m, incX, rnd)
+					yRe, yIm := randvec(n, incY, rnd), randvec(n, incY, rnd)
+					aRe, aIm := randvec(m*lda, 1, rnd), randvec(m*lda, 1, rnd)
+
+					x := splitToComplex(xRe, xIm)
+					y := splitToComplex(yRe, yIm)
+					want := splitToComplex(aRe, aIm)
+					impl.Zgeru(m, n, alpha, x, incX, y, incY, want, lda)
+
+					impl.DgerSplit(m, n, real(alpha), imag(alpha), xRe, xIm, incX, yRe, yIm, incY, aRe, aIm, lda)
+
+					prefix := fmt.Sprintf("m=%v,n=%v,incX=%v,incY=%v,lda=%v,alpha=%v", m, n, incX, incY, lda, alpha)
+					for i, w := range want {
+						g := complex(aRe[i], aIm[i])
+						if cmplx.Abs(g-w) > tol*math.Max(1, cmplx.Abs(w)) {
+							t.Errorf("%v: result differs from Zgeru at %v: want %v, got %v", prefix, i, w, g)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// splitToComplex returns the complex numbers with real parts re and
+// imaginary parts im.
+func splitToComplex(re, im []float64) []complex128 {
+	c := make([]complex128, len(re))
+	for i := range c {
+		c[i] = complex(re[i], im[i])
+	}
+	return c
+}
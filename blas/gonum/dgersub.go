@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// DgerSub performs the rank-one operation
+//  A[r0+i][c0+j] += alpha * x[r0+i] * y[c0+j]  for 0 ≤ i < mr, 0 ≤ j < nc
+// on the mr×nc sub-block of A with top left element A[r0][c0], where x is a
+// vector with r0+mr elements, y is a vector with c0+nc elements, and alpha is
+// a scalar. Only the sub-block of A and the corresponding segments of x and y
+// are referenced.
+//
+// The update is performed by Dger on the sub-block a[r0*lda+c0:] with leading
+// dimension lda, so the rows of A must have at least c0+nc elements.
+func (impl Implementation) DgerSub(mr, nc int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int, r0, c0 int) {
+	if mr < 0 {
+		panic(mLT0)
+	}
+	if nc < 0 {
+		panic(nLT0)
+	}
+	if r0 < 0 {
+		panic(r0LT0)
+	}
+	if c0 < 0 {
+		panic(c0LT0)
+	}
+	if lda < max(1, c0+nc) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if mr == 0 || nc == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	m := r0 + mr
+	n := c0 + nc
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// With a negative increment the last elements of a vector come first in
+	// the slice, so the segment starts at the beginning of the slice.
+	var offX, offY int
+	if incX > 0 {
+		offX = r0 * incX
+	}
+	if incY > 0 {
+		offY = c0 * incY
+	}
+	impl.Dger(mr, nc, alpha, x[offX:], incX, y[offY:], incY, a[r0*lda+c0:], lda)
+}
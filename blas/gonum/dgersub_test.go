@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgerSub(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, r0, c0, mr, nc int
+	}{
+		{m: 1, n: 1, r0: 0, c0: 0, mr: 1, nc: 1},
+		{m: 5, n: 6, r0: 0, c0: 0, mr: 5, nc: 6},
+		{m: 5, n: 6, r0: 1, c0: 2, mr: 3, nc: 2},
+		{m: 8, n: 4, r0: 7, c0: 3, mr: 1, nc: 1},
+		{m: 7, n: 9, r0: 2, c0: 4, mr: 5, nc: 5},
+		{m: 4, n: 4, r0: 2, c0: 1, mr: 0, nc: 3},
+	} {
+		for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {-2, -3}} {
+			name := fmt.Sprintf("%+v,incX=%d,incY=%d", test, inc.x, inc.y)
+			const alpha = -1.5
+			lda := test.n + 2
+			// x and y hold r0+mr and c0+nc elements, the rows and columns
+			// of A up to the end of the sub-block.
+			a := randomDense(rnd, test.m, test.n, lda)
+			lenX, lenY := test.r0+test.mr, test.c0+test.nc
+			x := randvec(lenX, inc.x, rnd)
+			y := randvec(lenY, inc.y, rnd)
+
+			// Copy the sub-block and the segments of x and y into their
+			// own storage and update them with Dger.
+			sub := make([]float64, test.mr*test.nc)
+			for i := 0; i < test.mr; i++ {
+				copy(sub[i*test.nc:(i+1)*test.nc], a[(test.r0+i)*lda+test.c0:])
+			}
+			xs := make([]float64, test.mr)
+			for i := range xs {
+				xs[i] = x[vecIndex(test.r0+i, lenX, inc.x)]
+			}
+			ys := make([]float64, test.nc)
+			for j := range ys {
+				ys[j] = y[vecIndex(test.c0+j, lenY, inc.y)]
+			}
+			impl.Dger(test.mr, test.nc, alpha, xs, 1, ys, 1, sub, max(1, test.nc))
+			want := make([]float64, len(a))
+			copy(want, a)
+			for i := 0; i < test.mr; i++ {
+				copy(want[(test.r0+i)*lda+test.c0:], sub[i*test.nc:(i+1)*test.nc])
+			}
+
+			got := make([]float64, len(a))
+			copy(got, a)
+			impl.DgerSub(test.mr, test.nc, alpha, x, inc.x, y, inc.y, got, lda, test.r0, test.c0)
+			if !floats.Same(got, want) {
+				t.Errorf("%s: result differs from Dger on the copied sub-block", name)
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/internal/asm/f64"
+
+// DgerWeighted performs the weighted sum of rank-one operations
+//  A += alpha * sum_k w[k] * x_k * y_kᵀ
+// where A is an m×n dense matrix, x_k is column k of the m×p dense matrix X,
+// y_k is column k of the n×p dense matrix Y, w is a vector of p weights, and
+// alpha is a scalar. X and Y are stored in x and y with leading dimensions
+// ldx and ldy.
+//
+// DgerWeighted returns the same result as p calls to Dger with the scalars
+// alpha*w[k] and the columns of X and Y as vectors, up to summation order,
+// but reads and writes each element of A once.
+func (Implementation) DgerWeighted(m, n, p int, alpha float64, x []float64, ldx int, y []float64, ldy int, w []float64, a []float64, lda int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if p < 0 {
+		panic(pLT0)
+	}
+	if ldx < max(1, p) {
+		panic(badLdX)
+	}
+	if ldy < max(1, p) {
+		panic(badLdY)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) < ldx*(m-1)+p {
+		panic(shortX)
+	}
+	if len(y) < ldy*(n-1)+p {
+		panic(shortY)
+	}
+	if len(w) < p {
+		panic(shortW)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if p == 0 || alpha == 0 {
+		return
+	}
+
+	// Element (i, j) of the update is the dot product of the weighted
+	// row i of X with row j of Y.
+	tmp := make([]float64, p)
+	for i := 0; i < m; i++ {
+		for k, v := range x[i*ldx : i*ldx+p] {
+			tmp[k] = alpha * w[k] * v
+		}
+		aRow := a[i*lda : i*lda+n]
+		for j := range aRow {
+			aRow[j] += f64.DotUnitary(tmp, y[j*ldy:j*ldy+p])
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDgerWeighted(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 3, 6} {
+		for _, n := range []int{0, 1, 4, 5} {
+			for _, p := range []int{0, 1, 2, 7} {
+				for _, pad := range []int{0, 3} {
+					for _, alpha := range []float64{0, 1, -0.5} {
+						ldx := max(1, p) + pad
+						ldy := max(1, p) + 2*pad
+						lda := max(1, n) + pad
+						x := randvec(m*ldx, 1, rnd)
+						y := randvec(n*ldy, 1, rnd)
+						w := randvec(p, 1, rnd)
+						a := randvec(m*lda, 1, rnd)
+
+						want := make([]float64, len(a))
+						copy(want, a)
+						for k := 0; k < p && m > 0 && n > 0; k++ {
+							impl.Dger(m, n, alpha*w[k], x[k:], ldx, y[k:], ldy, want, lda)
+						}
+
+						got := make([]float64, len(a))
+						copy(got, a)
+						impl.DgerWeighted(m, n, p, alpha, x, ldx, y, ldy, w, got, lda)
+
+						name := fmt.Sprintf("m=%v,n=%v,p=%v,ldx=%v,ldy=%v,lda=%v,alpha=%v", m, n, p, ldx, ldy, lda, alpha)
+						for i := range want {
+							if math.Abs(got[i]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+								t.Errorf("%v: result differs from weighted Dger calls at %v: want %v, got %v", name, i, want[i], got[i])
+								break
+							}
+						}
+						for i := 0; i < m; i++ {
+							for j := n; j < lda; j++ {
+								if got[i*lda+j] != a[i*lda+j] {
+									t.Errorf("%v: padding of A modified at row %v", name, i)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
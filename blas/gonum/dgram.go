@@ -0,0 +1,54 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/internal/asm/f64"
+
+// DgramUpper computes the upper triangle of the Gram matrix
+//  G = X * Xᵀ
+// where X is an m×n dense matrix whose rows are the vectors x_0, ..., x_{m-1}
+// and G is an m×m symmetric matrix with elements G[i][j] = x_i · x_j.
+// X is stored in x with leading dimension ldx and G in g with leading
+// dimension ldg.
+//
+// Only the elements of g on and above the diagonal are written; the strictly
+// lower triangle of g is not referenced. The result is the same as the upper
+// triangle computed by Dsyrk with blas.Upper, blas.NoTrans, alpha = 1 and
+// beta = 0, up to summation order.
+func (Implementation) DgramUpper(m, n int, x []float64, ldx int, g []float64, ldg int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if ldx < max(1, n) {
+		panic(badLdX)
+	}
+	if ldg < max(1, m) {
+		panic(badLdG)
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) < ldx*(m-1)+n {
+		panic(shortX)
+	}
+	if len(g) < ldg*(m-1)+m {
+		panic(shortG)
+	}
+
+	for i := 0; i < m; i++ {
+		xi := x[i*ldx : i*ldx+n]
+		gRow := g[i*ldg : i*ldg+m]
+		for j := i; j < m; j++ {
+			gRow[j] = f64.DotUnitary(xi, x[j*ldx:j*ldx+n])
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgramUpper(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10} {
+		for _, n := range []int{0, 1, 3, 7} {
+			for _, ldx := range []int{max(1, n), n + 4} {
+				for _, ldg := range []int{max(1, m), m + 3} {
+					x := randvec(max(0, ldx*(m-1)+n), 1, rnd)
+					xCopy := make([]float64, len(x))
+					copy(xCopy, x)
+
+					// Compute the full X * Xᵀ as the reference.
+					want := make([]float64, m*m)
+					if m > 0 {
+						impl.Dgemm(blas.NoTrans, blas.Trans, m, m, n, 1, x, ldx, x, ldx, 0, want, max(1, m))
+					}
+
+					g := make([]float64, max(0, ldg*(m-1)+m))
+					for i := range g {
+						g[i] = math.NaN()
+					}
+					impl.DgramUpper(m, n, x, ldx, g, ldg)
+
+					if !floats.Same(x, xCopy) {
+						t.Errorf("m=%v,n=%v,ldx=%v,ldg=%v: unexpected modification of x", m, n, ldx, ldg)
+					}
+					for i := 0; i < m; i++ {
+						for j := 0; j < m; j++ {
+							got := g[i*ldg+j]
+							if j < i {
+								if !math.IsNaN(got) {
+									t.Errorf("m=%v,n=%v,ldx=%v,ldg=%v: unexpected modification of lower triangle at (%v,%v)", m, n, ldx, ldg, i, j)
+								}
+								continue
+							}
+							if !floats.EqualWithinAbsOrRel(got, want[i*m+j], tol, tol) {
+								t.Errorf("m=%v,n=%v,ldx=%v,ldg=%v: unexpected G[%v][%v]: want %v, got %v", m, n, ldx, ldg, i, j, want[i*m+j], got)
+							}
+						}
+						// Elements beyond the m columns of the row must not be touched.
+						if i < m-1 {
+							for j := m; j < ldg; j++ {
+								if !math.IsNaN(g[i*ldg+j]) {
+									t.Errorf("m=%v,n=%v,ldx=%v,ldg=%v: unexpected modification of g outside the matrix at row %v", m, n, ldx, ldg, i)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgramUpperPanics(t *testing.T) {
+	x := make([]float64, 6)
+	g := make([]float64, 4)
+	if !panics(func() { impl.DgramUpper(-1, 3, x, 3, g, 2) }) {
+		t.Errorf("expected panic for m < 0")
+	}
+	if !panics(func() { impl.DgramUpper(2, 3, x, 2, g, 2) }) {
+		t.Errorf("expected panic for bad ldx")
+	}
+	if !panics(func() { impl.DgramUpper(2, 3, x, 3, g, 1) }) {
+		t.Errorf("expected panic for bad ldg")
+	}
+	if !panics(func() { impl.DgramUpper(2, 3, x[:5], 3, g, 2) }) {
+		t.Errorf("expected panic for short x")
+	}
+	if !panics(func() { impl.DgramUpper(2, 3, x, 3, g[:3], 2) }) {
+		t.Errorf("expected panic for short g")
+	}
+}
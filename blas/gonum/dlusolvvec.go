@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DlusolvVec solves the system of equations
+//  A * x = b
+// where A is an n×n matrix given by its LU factorization with partial
+// pivoting
+//  A = P * L * U
+// as computed by Dgetrf. The unit lower triangular L is stored below the
+// diagonal of lu and the upper triangular U on and above it. The row
+// interchanges are described by ipiv as for DtrsvPermuted: row i was
+// interchanged with row ipiv[i], and the elements of ipiv are zero-based.
+//
+// b is not modified and the solution is stored into x. b and x may be the
+// same slice.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (impl Implementation) DlusolvVec(n int, lu []float64, lda int, ipiv []int, b, x []float64) {
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(lu) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if len(ipiv) < n {
+		panic(shortIPiv)
+	}
+	for _, p := range ipiv[:n] {
+		if p < 0 || n <= p {
+			panic(badIPiv)
+		}
+	}
+	if len(b) < n {
+		panic(shortB)
+	}
+	if len(x) < n {
+		panic(shortX)
+	}
+
+	copy(x[:n], b[:n])
+	// Apply Pᵀ to b, then solve L * U * x = Pᵀ * b.
+	for i, p := range ipiv[:n] {
+		if p != i {
+			x[i], x[p] = x[p], x[i]
+		}
+	}
+	impl.Dtrsv(blas.Lower, blas.NoTrans, blas.Unit, n, lu, lda, x, 1)
+	impl.Dtrsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, lu, lda, x, 1)
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDlusolvVec(t *testing.T) {
+	const tol = 1e-12
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 31} {
+		for _, lda := range []int{max(1, n), n + 4} {
+			name := fmt.Sprintf("n=%v,lda=%v", n, lda)
+			a := make([]float64, n*lda)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			b := randvec(n, 1, rnd)
+			bCopy := make([]float64, len(b))
+			copy(bCopy, b)
+
+			lu := make([]float64, len(a))
+			copy(lu, a)
+			ipiv := make([]int, n)
+			if !luFactor(n, lu, lda, ipiv) {
+				t.Fatalf("%v: singular matrix", name)
+			}
+
+			x := make([]float64, n)
+			impl.DlusolvVec(n, lu, lda, ipiv, b, x)
+			if !floats.Same(b, bCopy) {
+				t.Errorf("%v: b modified", name)
+			}
+
+			// Check the scaled residual |A*x - b| / (|A| |x|).
+			if n == 0 {
+				continue
+			}
+			r := make([]float64, n)
+			copy(r, b)
+			impl.Dgemv(blas.NoTrans, n, n, 1, a, lda, x, 1, -1, r, 1)
+			var aNorm float64
+			for i := 0; i < n; i++ {
+				aNorm = math.Max(aNorm, impl.Dasum(n, a[i*lda:], 1))
+			}
+			resid := impl.Dnrm2(n, r, 1) / (aNorm * impl.Dnrm2(n, x, 1))
+			if resid > tol {
+				t.Errorf("%v: residual too large: %v", name, resid)
+			}
+
+			// Solving in place gives the same result.
+			copy(b, bCopy)
+			impl.DlusolvVec(n, lu, lda, ipiv, b, b)
+			if !floats.Same(b, x) {
+				t.Errorf("%v: in-place solve differs\nwant %v\ngot  %v", name, x, b)
+			}
+		}
+	}
+}
+
+// luFactor computes the LU factorization of the n×n matrix a with partial
+// pivoting in place, storing the zero-based row interchanges in ipiv. It
+// returns false if a is singular.
+func luFactor(n int, a []float64, lda int, ipiv []int) bool {
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i*lda+k]) > math.Abs(a[p*lda+k]) {
+				p = i
+			}
+		}
+		ipiv[k] = p
+		if a[p*lda+k] == 0 {
+			return false
+		}
+		if p != k {
+			impl.Dswap(n, a[k*lda:], 1, a[p*lda:], 1)
+		}
+		for i := k + 1; i < n; i++ {
+			a[i*lda+k] /= a[k*lda+k]
+			impl.Daxpy(n-k-1, -a[i*lda+k], a[k*lda+k+1:], 1, a[i*lda+k+1:], 1)
+		}
+	}
+	return true
+}
+
+func TestDlusolvVecPanics(t *testing.T) {
+	lu := []float64{2, 1, 1, 3}
+	ipiv := []int{0, 1}
+	b := []float64{1, 2}
+	x := make([]float64, 2)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"n < 0", func() { impl.DlusolvVec(-1, lu, 2, ipiv, b, x) }},
+		{"bad lda", func() { impl.DlusolvVec(2, lu, 1, ipiv, b, x) }},
+		{"short lu", func() { impl.DlusolvVec(2, lu[:3], 2, ipiv, b, x) }},
+		{"short ipiv", func() { impl.DlusolvVec(2, lu, 2, ipiv[:1], b, x) }},
+		{"bad ipiv", func() { impl.DlusolvVec(2, lu, 2, []int{2, 1}, b, x) }},
+		{"short b", func() { impl.DlusolvVec(2, lu, 2, ipiv, b[:1], x) }},
+		{"short x", func() { impl.DlusolvVec(2, lu, 2, ipiv, b, x[:1]) }},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%v: expected panic", test.name)
+		}
+	}
+}
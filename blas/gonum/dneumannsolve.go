@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DneumannSolve approximates the solution of (I - A) * x = b by the truncated
+// Neumann series
+//  x = sum_{k=0}^{terms} A^k * b
+// where A is an n×n dense matrix, and x and b are vectors of length n.
+//
+// Each term is formed from the previous one by Dgemv and added to x by Daxpy,
+// using two work vectors of length n for all the terms. The series converges
+// to (I - A)⁻¹ * b when the spectral radius of A is less than one, for
+// example when A is a contraction in some norm, and the error after terms
+// terms decreases like that radius to the power terms+1. The values of b are
+// not modified, and x and b may be the same slice.
+func (impl Implementation) DneumannSolve(n int, a []float64, lda int, b, x []float64, terms int) {
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if terms < 0 {
+		panic(termsLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if len(b) < n {
+		panic(shortB)
+	}
+	if len(x) < n {
+		panic(shortX)
+	}
+
+	if terms == 0 {
+		copy(x[:n], b[:n])
+		return
+	}
+
+	// v holds the current term A^k * b and w receives the next.
+	v := make([]float64, 2*n)
+	v, w := v[:n], v[n:]
+	copy(v, b[:n])
+	copy(x[:n], b[:n])
+	for k := 1; k <= terms; k++ {
+		impl.Dgemv(blas.NoTrans, n, n, 1, a, lda, v, 1, 0, w, 1)
+		impl.Daxpy(n, 1, w, 1, x, 1)
+		v, w = w, v
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDneumannSolve(t *testing.T) {
+	const (
+		norm = 0.5 // Bound on the infinity norm of A.
+		tol  = 1e-13
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 20} {
+		lda := n + 3
+		// Scale the rows of A so that A is a contraction in the infinity
+		// norm.
+		a := randomDense(rnd, n, n, lda)
+		for i := 0; i < n; i++ {
+			row := a[i*lda : i*lda+n]
+			s := norm / floats.Norm(row, 1)
+			for j := range row {
+				row[j] *= s * rnd.Float64()
+			}
+		}
+		xTrue := randvec(n, 1, rnd)
+		// Form b = (I - A) * xTrue.
+		b := make([]float64, n)
+		copy(b, xTrue)
+		impl.Dgemv(blas.NoTrans, n, n, -1, a, lda, xTrue, 1, 1, b, 1)
+		bCopy := make([]float64, n)
+		copy(bCopy, b)
+
+		for _, terms := range []int{0, 1, 2, 10, 60} {
+			name := fmt.Sprintf("n=%d,terms=%d", n, terms)
+			x := nanSlice(n)
+			impl.DneumannSolve(n, a, lda, b, x, terms)
+			if !floats.Same(b, bCopy) {
+				t.Errorf("%s: b modified", name)
+			}
+
+			// The error of the truncated series is A^(terms+1) * xTrue.
+			bound := math.Pow(norm, float64(terms+1))*floats.Norm(xTrue, math.Inf(1)) + tol
+			if dist := floats.Distance(x, xTrue, math.Inf(1)); dist > bound {
+				t.Errorf("%s: error %v exceeds bound %v", name, dist, bound)
+			}
+
+			// The result must not change when x and b share storage.
+			xb := make([]float64, n)
+			copy(xb, b)
+			impl.DneumannSolve(n, a, lda, xb, xb, terms)
+			if !floats.Same(xb, x) {
+				t.Errorf("%s: result differs when x and b are the same slice", name)
+			}
+		}
+	}
+}
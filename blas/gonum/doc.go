@@ -9,6 +9,9 @@ Package gonum is a Go implementation of the BLAS API. This implementation
 panics when the input arguments are invalid as per the standard, for example
 if a vector increment is zero. Note that the treatment of NaN values
 is not specified, and differs among the BLAS implementations.
+When built with the bounds tag, the Level 2 general, band, symmetric and
+triangular routines additionally check before computing that the largest
+index they will access is within the matrix slice.
 gonum.org/v1/gonum/blas/blas64 provides helpful wrapper functions to the BLAS
 interface. The rest of this text describes the layout of the data for the input types.
 
@@ -38,9 +41,13 @@ of successive rows. The stride must be at least as large as the number of column
 but may be longer.
 	[a00 ... a0n a0* ... a1stride-1 a21 ... amn am* ... amstride-1]
 Thus, dense[i*ld + j] refers to the {i, j}th element of the matrix.
+The padding elements marked * at the end of each row are never accessed, and
+the Level 2 routines never modify the matrix of a matrix-vector product.
 
 Symmetric and triangular matrices (non-packed) are stored identically to Dense,
-except that only elements in one triangle of the matrix are accessed.
+except that only elements in one triangle of the matrix are accessed. For
+triangular matrices with a unit diagonal the diagonal elements are not accessed
+either.
 
 Packed symmetric and packed triangular matrices are laid out with the entries
 condensed such that all of the unreferenced elements are removed. So, the upper triangular
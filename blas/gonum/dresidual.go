@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DresidualGemv computes the residual
+//  r = b - A * x
+// where A is an m×n dense matrix, and x, b and r are vectors.
+//
+// b is copied into r and the product is subtracted with Dgemv using
+// alpha = -1 and beta = 1, so the result is the same as that sequence of
+// calls. r may be the same vector as b, in which case the residual overwrites
+// b. If r and x share their first element, x is copied to a temporary before
+// r is written. Any other overlap between r and x or b gives an undefined
+// result.
+func (impl Implementation) DresidualGemv(m, n int, a []float64, lda int, x []float64, incX int, b []float64, incB int, r []float64, incR int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incB == 0 {
+		panic(zeroIncB)
+	}
+	if incR == 0 {
+		panic(zeroIncR)
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incB > 0 && len(b) <= (m-1)*incB) || (incB < 0 && len(b) <= (1-m)*incB) {
+		panic(shortB)
+	}
+	if (incR > 0 && len(r) <= (m-1)*incR) || (incR < 0 && len(r) <= (1-m)*incR) {
+		panic(shortR)
+	}
+	if n > 0 {
+		if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+			panic(shortX)
+		}
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+		if &x[0] == &r[0] {
+			// x and r alias, so copy x to a temporary to keep it from
+			// being overwritten by b.
+			tmp := make([]float64, n)
+			impl.Dcopy(n, x, incX, tmp, 1)
+			x = tmp
+			incX = 1
+		}
+	}
+
+	impl.Dcopy(m, b, incB, r, incR)
+	impl.Dgemv(blas.NoTrans, m, n, -1, a, lda, x, incX, 1, r, incR)
+}
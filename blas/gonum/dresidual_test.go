@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDresidualGemv(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {1, 4}, {4, 1}, {5, 5}, {7, 3}, {3, 8}} {
+		m, n := mn[0], mn[1]
+		for _, lda := range []int{max(1, n), n + 2} {
+			a := randvec(max(0, lda*(m-1)+n), 1, rnd)
+			for _, incX := range []int{1, -2} {
+				for _, incB := range []int{1, 3, -2} {
+					for _, incR := range []int{1, 2, -3} {
+						name := fmt.Sprintf("m=%v,n=%v,lda=%v,incX=%v,incB=%v,incR=%v", m, n, lda, incX, incB, incR)
+						x := randvec(n, incX, rnd)
+						b := randvec(m, incB, rnd)
+						r := randvec(m, incR, rnd)
+						rCopy := make([]float64, len(r))
+						copy(rCopy, r)
+						bCopy := make([]float64, len(b))
+						copy(bCopy, b)
+
+						want := make([]float64, m)
+						for i := range want {
+							sum := b[vecIndex(i, m, incB)]
+							for j := 0; j < n; j++ {
+								sum -= a[i*lda+j] * x[vecIndex(j, n, incX)]
+							}
+							want[i] = sum
+						}
+
+						impl.DresidualGemv(m, n, a, lda, x, incX, b, incB, r, incR)
+
+						if !floats.Same(b, bCopy) {
+							t.Errorf("%v: unexpected modification of b", name)
+						}
+						touched := make(map[int]bool)
+						for i := 0; i < m; i++ {
+							touched[vecIndex(i, m, incR)] = true
+						}
+						for i := range r {
+							if !touched[i] && r[i] != rCopy[i] {
+								t.Errorf("%v: unexpected modification of r at %v", name, i)
+							}
+						}
+						for i, w := range want {
+							got := r[vecIndex(i, m, incR)]
+							if !floats.EqualWithinAbsOrRel(got, w, tol, tol) {
+								t.Errorf("%v: unexpected r[%v]: want %v, got %v", name, i, w, got)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDresidualGemvAlias(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	const n = 6
+	a := randvec(n*n, 1, rnd)
+	x := randvec(n, 1, rnd)
+	b := randvec(n, 1, rnd)
+
+	want := make([]float64, n)
+	impl.DresidualGemv(n, n, a, n, x, 1, b, 1, want, 1)
+
+	// r is the same vector as b.
+	got := make([]float64, n)
+	copy(got, b)
+	impl.DresidualGemv(n, n, a, n, x, 1, got, 1, got, 1)
+	if !floats.EqualApprox(got, want, tol) {
+		t.Errorf("unexpected result with r aliasing b:\nwant %v\ngot  %v", want, got)
+	}
+
+	// r is the same vector as x.
+	copy(got, x)
+	impl.DresidualGemv(n, n, a, n, got, 1, b, 1, got, 1)
+	if !floats.EqualApprox(got, want, tol) {
+		t.Errorf("unexpected result with r aliasing x:\nwant %v\ngot  %v", want, got)
+	}
+}
+
+func TestDresidualGemvPanics(t *testing.T) {
+	a := make([]float64, 6)
+	x := make([]float64, 3)
+	b := make([]float64, 2)
+	r := make([]float64, 2)
+	if !panics(func() { impl.DresidualGemv(2, 3, a, 2, x, 1, b, 1, r, 1) }) {
+		t.Errorf("expected panic for bad lda")
+	}
+	if !panics(func() { impl.DresidualGemv(2, 3, a, 3, x, 1, b, 0, r, 1) }) {
+		t.Errorf("expected panic for zero incB")
+	}
+	if !panics(func() { impl.DresidualGemv(2, 3, a, 3, x, 1, b, 1, r, 0) }) {
+		t.Errorf("expected panic for zero incR")
+	}
+	if !panics(func() { impl.DresidualGemv(2, 3, a, 3, x, 1, b[:1], 1, r, 1) }) {
+		t.Errorf("expected panic for short b")
+	}
+	if !panics(func() { impl.DresidualGemv(2, 3, a, 3, x, 1, b, 1, r[:1], 1) }) {
+		t.Errorf("expected panic for short r")
+	}
+}
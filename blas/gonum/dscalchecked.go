@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// DscalChecked scales x by alpha and reports whether the scaling overflowed.
+//  x[i] *= alpha
+// DscalChecked returns true if any finite element of x became infinite or NaN
+// as a result of the scaling, either by overflow or because alpha is not
+// finite. Elements that are infinite or NaN on entry do not set the result.
+// The scaled values are the same as those computed by Dscal, and as for Dscal,
+// an alpha of zero sets x to zero and DscalChecked has no effect and returns
+// false if incX < 0.
+func (Implementation) DscalChecked(n int, alpha float64, x []float64, incX int) (overflow bool) {
+	if incX < 1 {
+		if incX == 0 {
+			panic(zeroIncX)
+		}
+		return false
+	}
+	if n < 1 {
+		if n == 0 {
+			return false
+		}
+		panic(nLT0)
+	}
+	if (n-1)*incX >= len(x) {
+		panic(shortX)
+	}
+	if alpha == 0 {
+		for ix := 0; ix < n*incX; ix += incX {
+			x[ix] = 0
+		}
+		return false
+	}
+	// The comparisons with math.MaxFloat64 are false for both infinities
+	// and NaN.
+	for ix := 0; ix < n*incX; ix += incX {
+		v := x[ix]
+		r := v * alpha
+		x[ix] = r
+		if math.Abs(v) <= math.MaxFloat64 && !(math.Abs(r) <= math.MaxFloat64) {
+			overflow = true
+		}
+	}
+	return overflow
+}
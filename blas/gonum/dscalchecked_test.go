@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDscalChecked(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		x     []float64
+		alpha float64
+		want  bool
+	}{
+		{x: nil, alpha: 2, want: false},
+		{x: []float64{1, -2, 3}, alpha: 2, want: false},
+		{x: []float64{1, -2, 3}, alpha: 0, want: false},
+		{x: []float64{1e300, 1, 2}, alpha: 1e10, want: true},
+		{x: []float64{1, 2, -1e300}, alpha: 1e10, want: true},
+		{x: []float64{1, 1e-300, 2}, alpha: 1e300, want: false},
+		{x: []float64{math.MaxFloat64, 0}, alpha: 1, want: false},
+		{x: []float64{math.MaxFloat64, 0}, alpha: -1.0000001, want: true},
+		{x: []float64{1, 2}, alpha: inf, want: true},
+		{x: []float64{0, 0}, alpha: inf, want: true},
+		{x: []float64{1, 2}, alpha: math.NaN(), want: true},
+		// Non-finite values on entry do not count as overflow.
+		{x: []float64{inf, 2}, alpha: 3, want: false},
+		{x: []float64{math.NaN(), 2}, alpha: 3, want: false},
+		{x: []float64{inf, 1e308}, alpha: 3, want: true},
+	} {
+		n := len(test.x)
+		for _, incX := range []int{1, 3} {
+			name := fmt.Sprintf("x=%v,alpha=%v,incX=%v", test.x, test.alpha, incX)
+			x := randvec(n, incX, rnd)
+			for i, v := range test.x {
+				x[i*incX] = v
+			}
+			want := make([]float64, len(x))
+			copy(want, x)
+			impl.Dscal(n, test.alpha, want, incX)
+
+			got := make([]float64, len(x))
+			copy(got, x)
+			overflow := impl.DscalChecked(n, test.alpha, got, incX)
+			if overflow != test.want {
+				t.Errorf("%v: unexpected overflow flag: got %v, want %v", name, overflow, test.want)
+			}
+			if !floats.Same(got, want) {
+				t.Errorf("%v: result differs from Dscal\nwant %v\ngot  %v", name, want, got)
+			}
+		}
+	}
+}
+
+func TestDscalCheckedNegativeInc(t *testing.T) {
+	x := []float64{1e300, 2, 3}
+	if impl.DscalChecked(2, 1e300, x, -1) {
+		t.Errorf("unexpected overflow for negative increment")
+	}
+	if !floats.Same(x, []float64{1e300, 2, 3}) {
+		t.Errorf("unexpected modification of x for negative increment: %v", x)
+	}
+	if !panics(func() { impl.DscalChecked(2, 1, x, 0) }) {
+		t.Errorf("expected panic for zero incX")
+	}
+	if !panics(func() { impl.DscalChecked(-1, 1, x, 1) }) {
+		t.Errorf("expected panic for n < 0")
+	}
+	if !panics(func() { impl.DscalChecked(2, 1, x, 3) }) {
+		t.Errorf("expected panic for short x")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// DscalVec scales the elements of x by the corresponding elements of d.
+//  x[i] *= d[i] for all i
+// This is the element-wise, or Hadamard, product used to apply a diagonal
+// matrix, for example in diagonal preconditioning. The increments of d and x
+// are independent and may be negative.
+func (Implementation) DscalVec(n int, d []float64, incD int, x []float64, incX int) {
+	if incD == 0 {
+		panic(zeroIncD)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if n < 1 {
+		if n == 0 {
+			return
+		}
+		panic(nLT0)
+	}
+	if (incD > 0 && len(d) <= (n-1)*incD) || (incD < 0 && len(d) <= (1-n)*incD) {
+		panic(shortD)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if incD == 1 && incX == 1 {
+		x = x[:n]
+		for i, v := range d[:n] {
+			x[i] *= v
+		}
+		return
+	}
+	var id, ix int
+	if incD < 0 {
+		id = (-n + 1) * incD
+	}
+	if incX < 0 {
+		ix = (-n + 1) * incX
+	}
+	for i := 0; i < n; i++ {
+		x[ix] *= d[id]
+		id += incD
+		ix += incX
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDscalVec(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10} {
+		for _, incD := range []int{1, 2, -1, -3} {
+			for _, incX := range []int{1, 3, -1, -2} {
+				d := randvec(n, incD, rnd)
+				x := randvec(n, incX, rnd)
+
+				want := make([]float64, len(x))
+				copy(want, x)
+				for i := 0; i < n; i++ {
+					want[vecIndex(i, n, incX)] *= d[vecIndex(i, n, incD)]
+				}
+				dCopy := make([]float64, len(d))
+				copy(dCopy, d)
+
+				impl.DscalVec(n, d, incD, x, incX)
+
+				name := fmt.Sprintf("n=%v,incD=%v,incX=%v", n, incD, incX)
+				for i := range want {
+					if x[i] != want[i] {
+						t.Errorf("%v: unexpected result at %v: want %v, got %v", name, i, want[i], x[i])
+						break
+					}
+				}
+				for i := range d {
+					if d[i] != dCopy[i] {
+						t.Errorf("%v: d modified at %v", name, i)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDscalVecPanics(t *testing.T) {
+	x := make([]float64, 4)
+	for _, test := range []struct {
+		name       string
+		n          int
+		d          []float64
+		incD, incX int
+	}{
+		{name: "n<0", n: -1, d: x, incD: 1, incX: 1},
+		{name: "incD=0", n: 4, d: x, incD: 0, incX: 1},
+		{name: "incX=0", n: 4, d: x, incD: 1, incX: 0},
+		{name: "short d", n: 4, d: x[:3], incD: 1, incX: 1},
+		{name: "short d negative", n: 2, d: x[:2], incD: -2, incX: 1},
+		{name: "short x", n: 3, d: x, incD: 1, incX: 2},
+	} {
+		if !panics(func() { impl.DscalVec(test.n, test.d, test.incD, x, test.incX) }) {
+			t.Errorf("%v: no panic", test.name)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DsymvBanded performs the matrix-vector operation
+//  y = alpha * A * x + beta * y
+// where A is an n×n symmetric matrix stored in dense format that is known to
+// have at most k non-zero off-diagonals on each side of the diagonal, x and y
+// are vectors, and alpha and beta are scalars.
+//
+// Only the elements of the ul triangle of A within k of the diagonal are
+// referenced, so DsymvBanded returns the same result as Dsymv when k == n-1
+// and is faster than Dsymv when k is small relative to n.
+func (Implementation) DsymvBanded(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	// Set up start points
+	var kx, ky int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(n - 1) * incY
+	}
+
+	// Form y = beta * y.
+	dscalePrepY(n, beta, y, incY)
+
+	if alpha == 0 {
+		return
+	}
+
+	// The band can never be wider than the matrix.
+	k = min(k, n-1)
+
+	if ul == blas.Upper {
+		if incX == 1 && incY == 1 {
+			for i := 0; i < n; i++ {
+				u := min(n, i+k+1)
+				atmp := a[i*lda+i+1 : i*lda+u]
+				xv := alpha * x[i]
+				sum := x[i]*a[i*lda+i] + f64.DotUnitary(atmp, x[i+1:u])
+				f64.AxpyUnitary(xv, atmp, y[i+1:u])
+				y[i] += alpha * sum
+			}
+			return
+		}
+		ix := kx
+		iy := ky
+		for i := 0; i < n; i++ {
+			xv := alpha * x[ix]
+			sum := x[ix] * a[i*lda+i]
+			jx := ix + incX
+			jy := iy + incY
+			atmp := a[i*lda+i+1 : i*lda+min(n, i+k+1)]
+			for _, v := range atmp {
+				sum += x[jx] * v
+				y[jy] += xv * v
+				jx += incX
+				jy += incY
+			}
+			y[iy] += alpha * sum
+			ix += incX
+			iy += incY
+		}
+		return
+	}
+	// Cases where a is lower triangular.
+	if incX == 1 && incY == 1 {
+		for i := 0; i < n; i++ {
+			l := max(0, i-k)
+			atmp := a[i*lda+l : i*lda+i]
+			xv := alpha * x[i]
+			sum := f64.DotUnitary(atmp, x[l:i]) + x[i]*a[i*lda+i]
+			f64.AxpyUnitary(xv, atmp, y[l:i])
+			y[i] += alpha * sum
+		}
+		return
+	}
+	ix := kx
+	iy := ky
+	for i := 0; i < n; i++ {
+		l := max(0, i-k)
+		jx := kx + l*incX
+		jy := ky + l*incY
+		xv := alpha * x[ix]
+		atmp := a[i*lda+l : i*lda+i]
+		var sum float64
+		for _, v := range atmp {
+			sum += x[jx] * v
+			y[jy] += xv * v
+			jx += incX
+			jy += incY
+		}
+		sum += x[ix] * a[i*lda+i]
+		y[iy] += alpha * sum
+		ix += incX
+		iy += incY
+	}
+}
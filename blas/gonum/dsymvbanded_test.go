@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsymvBanded(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 5, 10} {
+			for _, k := range []int{0, 1, 2, n - 1, n + 3} {
+				if k < 0 {
+					continue
+				}
+				for _, inc := range [][2]int{{1, 1}, {2, 3}, {-3, 2}, {-2, -4}} {
+					for _, lda := range []int{n, n + 3} {
+						testDsymvBanded(t, rnd, ul, n, k, lda, inc[0], inc[1])
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDsymvBanded(t *testing.T, rnd *rand.Rand, ul blas.Uplo, n, k, lda, incX, incY int) {
+	const tol = 1e-14
+
+	// Fill the whole of A with random values and then zero the elements
+	// outside the band so that A is genuinely banded.
+	a := make([]float64, lda*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if j-i > k || i-j > k {
+				continue
+			}
+			a[i*lda+j] = rnd.NormFloat64()
+		}
+	}
+	x := randvec(n, incX, rnd)
+	y := randvec(n, incY, rnd)
+	alpha := 1.5
+	beta := -0.5
+
+	want := make([]float64, len(y))
+	copy(want, y)
+	impl.Dsymv(ul, n, alpha, a, lda, x, incX, beta, want, incY)
+
+	got := make([]float64, len(y))
+	copy(got, y)
+	impl.DsymvBanded(ul, n, k, alpha, a, lda, x, incX, beta, got, incY)
+
+	prefix := fmt.Sprintf("ul=%v,n=%v,k=%v,lda=%v,incX=%v,incY=%v", ul, n, k, lda, incX, incY)
+	if !floats.EqualApprox(got, want, tol) {
+		t.Errorf("%v: result differs from Dsymv\nwant %v\ngot  %v", prefix, want, got)
+	}
+}
+
+func BenchmarkDsymvBanded(b *testing.B) {
+	for _, n := range []int{100, 1000} {
+		for _, k := range []int{1, 4, 16} {
+			benchmarkDsymvBanded(b, n, k)
+		}
+	}
+}
+
+func benchmarkDsymvBanded(b *testing.B, n, k int) {
+	rnd := rand.New(rand.NewSource(1))
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-k); j < min(n, i+k+1); j++ {
+			a[i*n+j] = rnd.NormFloat64()
+		}
+	}
+	x := randvec(n, 1, rnd)
+	y := make([]float64, n)
+	b.Run(fmt.Sprintf("Dsymv/n=%d,k=%d", n, k), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			impl.Dsymv(blas.Upper, n, 1, a, n, x, 1, 0, y, 1)
+		}
+	})
+	b.Run(fmt.Sprintf("DsymvBanded/n=%d,k=%d", n, k), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			impl.DsymvBanded(blas.Upper, n, k, 1, a, n, x, 1, 0, y, 1)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/testblas"
+)
+
+func BenchmarkDsymv(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, inc := range []int{1, 3} {
+				b.Run(fmt.Sprintf("n=%d,ul=%c,inc=%d", n, ul, inc), func(b *testing.B) {
+					testblas.DsymvBenchmark(b, impl, ul, n, inc, inc)
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkDsbmv(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		for _, k := range []int{1, 16, 64} {
+			for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, inc := range []int{1, 3} {
+					b.Run(fmt.Sprintf("n=%d,k=%d,ul=%c,inc=%d", n, k, ul, inc), func(b *testing.B) {
+						testblas.DsbmvBenchmark(b, impl, ul, n, k, inc, inc)
+					})
+				}
+			}
+		}
+	}
+}
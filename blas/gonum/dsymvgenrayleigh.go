@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DsymvGenRayleigh returns the generalized Rayleigh quotient
+//  (xᵀ * A * x) / (xᵀ * B * x)
+// where A and B are n×n symmetric matrices and x is a vector. Only the
+// triangles of A and B specified by ul are referenced.
+//
+// If x is a generalized eigenvector of the pair (A, B), that is
+// A * x = λ * B * x, the quotient is the eigenvalue λ. Both products are
+// formed by Dsymv in one work vector of length n and reduced with Ddot. If B
+// is not positive definite the denominator may be zero, giving an infinite or
+// NaN result. DsymvGenRayleigh returns NaN if n is zero.
+func (impl Implementation) DsymvGenRayleigh(ul blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, x []float64, incX int) float64 {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if ldb < max(1, n) {
+		panic(badLdB)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return math.NaN()
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+n {
+		panic(shortB)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+
+	work := make([]float64, n)
+	impl.Dsymv(ul, n, 1, a, lda, x, incX, 0, work, 1)
+	num := impl.Ddot(n, x, incX, work, 1)
+	impl.Dsymv(ul, n, 1, b, ldb, x, incX, 0, work, 1)
+	den := impl.Ddot(n, x, incX, work, 1)
+	return num / den
+}
@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsymvGenRayleighEigen(t *testing.T) {
+	// The pair
+	//  A = [4 1]  B = [2 0]
+	//      [1 3]      [0 1]
+	// has the generalized eigenvalues λ = (5 ± √3)/2, the roots of
+	// det(A - λB) = 2λ² - 10λ + 11, with eigenvectors [1, 2λ-4].
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		a := []float64{4, 1, 1, 3}
+		b := []float64{2, 0, 0, 1}
+		for _, lambda := range []float64{(5 + math.Sqrt(3)) / 2, (5 - math.Sqrt(3)) / 2} {
+			for _, scale := range []float64{1, -3} {
+				x := []float64{scale, scale * (2*lambda - 4)}
+				got := impl.DsymvGenRayleigh(ul, 2, a, 2, b, 2, x, 1)
+				if !floats.EqualWithinAbsOrRel(got, lambda, 1e-14, 1e-14) {
+					t.Errorf("ul=%c,lambda=%v,scale=%v: unexpected quotient: got %v", ul, lambda, scale, got)
+				}
+			}
+		}
+	}
+}
+
+func TestDsymvGenRayleigh(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 3, 10} {
+			for _, incX := range []int{1, -2} {
+				name := fmt.Sprintf("ul=%c,n=%d,incX=%d", ul, n, incX)
+				lda, ldb := n+1, n+2
+				a := randomSymmetric(rnd, ul, n, lda)
+				b := randomSymmetric(rnd, ul, n, ldb)
+				// A positive diagonal makes the diagonally dominant B
+				// positive definite, so the denominator is positive.
+				for i := 0; i < n; i++ {
+					b[i*ldb+i] = math.Abs(b[i*ldb+i])
+				}
+				x := randvec(n, incX, rnd)
+				xCopy := make([]float64, len(x))
+				copy(xCopy, x)
+
+				// Form both quadratic forms from the full matrices.
+				sym := func(m []float64, ld, i, j int) float64 {
+					if (ul == blas.Upper) == (i > j) {
+						i, j = j, i
+					}
+					return m[i*ld+j]
+				}
+				var num, den float64
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						xij := x[vecIndex(i, n, incX)] * x[vecIndex(j, n, incX)]
+						num += sym(a, lda, i, j) * xij
+						den += sym(b, ldb, i, j) * xij
+					}
+				}
+
+				got := impl.DsymvGenRayleigh(ul, n, a, lda, b, ldb, x, incX)
+				if want := num / den; !floats.EqualWithinAbsOrRel(got, want, tol, tol) {
+					t.Errorf("%s: unexpected quotient: got %v, want %v", name, got, want)
+				}
+				if !floats.Same(x, xCopy) {
+					t.Errorf("%s: x modified", name)
+				}
+			}
+		}
+	}
+	if got := impl.DsymvGenRayleigh(blas.Upper, 0, nil, 1, nil, 1, nil, 1); !math.IsNaN(got) {
+		t.Errorf("unexpected quotient for n = 0: got %v, want NaN", got)
+	}
+}
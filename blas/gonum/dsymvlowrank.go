@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DsymvLowRank performs the matrix-vector operation
+//  y = (D + U * Uᵀ) * x + beta * y
+// where D is the n×n diagonal matrix with diagonal d, U is an n×rank dense
+// matrix, x and y are vectors, and beta is a scalar. The symmetric matrix
+// D + U * Uᵀ is not formed.
+//
+// Uᵀ * x is computed into a temporary of length rank by Dgemv, y is set to
+// beta * y + D * x element by element, and U * (Uᵀ * x) is added to y by a
+// second Dgemv, so the cost is O(n*rank). x and y may be the same vector if
+// incX == incY.
+func (impl Implementation) DsymvLowRank(n int, d []float64, u []float64, ldu int, rank int, x []float64, incX int, beta float64, y []float64, incY int) {
+	if n < 0 {
+		panic(nLT0)
+	}
+	if rank < 0 {
+		panic(rankLT0)
+	}
+	if ldu < max(1, rank) {
+		panic(badLdU)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(d) < n {
+		panic(shortD)
+	}
+	if len(u) < ldu*(n-1)+rank {
+		panic(shortU)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+
+	// Form t = Uᵀ * x before y is updated.
+	var t []float64
+	if rank > 0 {
+		t = make([]float64, rank)
+		impl.Dgemv(blas.Trans, n, rank, 1, u, ldu, x, incX, 0, t, 1)
+	}
+
+	// Form y = beta * y + D * x.
+	var kx, ky int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(n - 1) * incY
+	}
+	ix := kx
+	iy := ky
+	for _, di := range d[:n] {
+		if beta == 0 {
+			y[iy] = di * x[ix]
+		} else {
+			y[iy] = beta*y[iy] + di*x[ix]
+		}
+		ix += incX
+		iy += incY
+	}
+
+	// Form y += U * t.
+	if rank > 0 {
+		impl.Dgemv(blas.NoTrans, n, rank, 1, u, ldu, t, 1, 1, y, incY)
+	}
+}
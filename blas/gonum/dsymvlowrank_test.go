@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsymvLowRank(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 10} {
+		for _, rank := range []int{0, 1, 3} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, -3}, {-1, 1}} {
+				for _, beta := range []float64{0, 0.5} {
+					name := fmt.Sprintf("n=%d,rank=%d,incX=%d,incY=%d,beta=%v", n, rank, inc.x, inc.y, beta)
+					ldu := rank + 2
+					d := randvec(n, 1, rnd)
+					u := randomDense(rnd, n, rank, ldu)
+					x := randvec(n, inc.x, rnd)
+					y := randvec(n, inc.y, rnd)
+					if beta == 0 {
+						// y must not be read.
+						for i := 0; i < n; i++ {
+							y[vecIndex(i, n, inc.y)] = math.NaN()
+						}
+					}
+
+					// Form A = D + U * Uᵀ densely.
+					a := make([]float64, n*n)
+					for i := 0; i < n; i++ {
+						a[i*n+i] = d[i]
+						for j := 0; j < n; j++ {
+							for k := 0; k < rank; k++ {
+								a[i*n+j] += u[i*ldu+k] * u[j*ldu+k]
+							}
+						}
+					}
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dsymv(blas.Upper, n, 1, a, n, x, inc.x, beta, want, inc.y)
+
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DsymvLowRank(n, d, u, ldu, rank, x, inc.x, beta, got, inc.y)
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%s: unexpected result: got %v, want %v", name, got, want)
+					}
+
+					// x and y may be the same vector.
+					if inc.x == inc.y {
+						xy := make([]float64, len(x))
+						copy(xy, x)
+						want := make([]float64, len(x))
+						copy(want, x)
+						impl.Dsymv(blas.Upper, n, 1, a, n, x, inc.x, beta, want, inc.x)
+						impl.DsymvLowRank(n, d, u, ldu, rank, xy, inc.x, beta, xy, inc.x)
+						if !floats.EqualApprox(xy, want, tol) {
+							t.Errorf("%s: unexpected result with x and y the same vector: got %v, want %v", name, xy, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DsymvMirror performs the matrix-vector operation
+//  y = alpha * A * x + beta * y
+// where A is an n×n symmetric matrix, x and y are vectors, and alpha and
+// beta are scalars.
+//
+// If mirror is false, DsymvMirror is equivalent to Dsymv. If mirror is true,
+// only the triangle of A opposite to ul is stored, and each element (i, j)
+// of the ul triangle is read from its transposed position a[j*lda+i]. This
+// allows a matrix with only one triangle populated to be used where the
+// other triangle is requested without first copying it.
+func (impl Implementation) DsymvMirror(ul blas.Uplo, mirror bool, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if mirror {
+		// Since A is symmetric, reading the transposed element of every
+		// element of one triangle is the same as reading the other triangle.
+		if ul == blas.Upper {
+			ul = blas.Lower
+		} else {
+			ul = blas.Upper
+		}
+	}
+	impl.Dsymv(ul, n, alpha, a, lda, x, incX, beta, y, incY)
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsymvMirror(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, stored := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, n := range []int{1, 2, 3, 5, 10} {
+			for _, lda := range []int{n, n + 3} {
+				for _, inc := range [][2]int{{1, 1}, {2, -3}} {
+					incX, incY := inc[0], inc[1]
+
+					// Populate only the stored triangle of a, and form
+					// the full symmetric matrix in aFull.
+					a := make([]float64, n*lda)
+					aFull := make([]float64, n*n)
+					for i := range a {
+						a[i] = math.NaN()
+					}
+					for i := 0; i < n; i++ {
+						for j := 0; j <= i; j++ {
+							v := rnd.NormFloat64()
+							if stored == blas.Lower {
+								a[i*lda+j] = v
+							} else {
+								a[j*lda+i] = v
+							}
+							aFull[i*n+j] = v
+							aFull[j*n+i] = v
+						}
+					}
+					x := randvec(n, incX, rnd)
+					y := randvec(n, incY, rnd)
+
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dgemv(blas.NoTrans, n, n, 1.5, aFull, n, x, incX, -0.5, want, incY)
+
+					requested := blas.Upper
+					if stored == blas.Upper {
+						requested = blas.Lower
+					}
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DsymvMirror(requested, true, n, 1.5, a, lda, x, incX, -0.5, got, incY)
+
+					prefix := fmt.Sprintf("stored=%v,n=%v,lda=%v,incX=%v,incY=%v", stored, n, lda, incX, incY)
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%v: unexpected result with mirror\nwant %v\ngot  %v", prefix, want, got)
+					}
+
+					copy(got, y)
+					impl.DsymvMirror(stored, false, n, 1.5, a, lda, x, incX, -0.5, got, incY)
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%v: unexpected result without mirror\nwant %v\ngot  %v", prefix, want, got)
+					}
+				}
+			}
+		}
+	}
+}
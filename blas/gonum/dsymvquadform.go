@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DsymvQuadForm returns the quadratic form
+//  xᵀ * A * x
+// where A is an n×n symmetric matrix and x is a vector. Only the triangle of A
+// specified by ul is referenced.
+//
+// The result is equal to Ddot(n, x, incX, y, incY) with y = A * x computed by
+// Dsymv, but the product is accumulated row by row as
+//  Σ_i x[i] * (A[i][i]*x[i] + 2 * Σ_{j in triangle, j≠i} A[i][j]*x[j])
+// so that y is not formed and each stored element of A is read once.
+func (Implementation) DsymvQuadForm(ul blas.Uplo, n int, a []float64, lda int, x []float64, incX int) float64 {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+
+	var sum float64
+	if incX == 1 {
+		if ul == blas.Upper {
+			for i := 0; i < n; i++ {
+				xi := x[i]
+				sum += xi * (a[i*lda+i]*xi + 2*f64.DotUnitary(a[i*lda+i+1:i*lda+n], x[i+1:n]))
+			}
+			return sum
+		}
+		for i := 0; i < n; i++ {
+			xi := x[i]
+			sum += xi * (a[i*lda+i]*xi + 2*f64.DotUnitary(a[i*lda:i*lda+i], x[:i]))
+		}
+		return sum
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	ix := kx
+	if ul == blas.Upper {
+		for i := 0; i < n; i++ {
+			xi := x[ix]
+			dot := f64.DotInc(x, a[i*lda+i+1:i*lda+n], uintptr(n-i-1), uintptr(incX), 1, uintptr(ix+incX), 0)
+			sum += xi * (a[i*lda+i]*xi + 2*dot)
+			ix += incX
+		}
+		return sum
+	}
+	for i := 0; i < n; i++ {
+		xi := x[ix]
+		dot := f64.DotInc(x, a[i*lda:i*lda+i], uintptr(i), uintptr(incX), 1, uintptr(kx), 0)
+		sum += xi * (a[i*lda+i]*xi + 2*dot)
+		ix += incX
+	}
+	return sum
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsymvQuadForm(t *testing.T) {
+	const tol = 1e-12
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 7, 16, 33} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, incX := range []int{1, 2, -1, -3} {
+					name := fmt.Sprintf("ul=%v,n=%v,lda=%v,incX=%v", ul, n, lda, incX)
+					a := randomSymmetric(rnd, ul, n, lda)
+					x := randvec(n, incX, rnd)
+					xCopy := make([]float64, len(x))
+					copy(xCopy, x)
+
+					y := make([]float64, n)
+					impl.Dsymv(ul, n, 1, a, lda, x, incX, 0, y, 1)
+					var want float64
+					if n > 0 {
+						xUnit := make([]float64, n)
+						impl.Dcopy(n, x, incX, xUnit, 1)
+						want = impl.Ddot(n, xUnit, 1, y, 1)
+					}
+
+					got := impl.DsymvQuadForm(ul, n, a, lda, x, incX)
+					if !floats.EqualWithinAbsOrRel(got, want, tol, tol) {
+						t.Errorf("%v: unexpected result: got %v, want %v", name, got, want)
+					}
+					if !floats.Same(x, xCopy) {
+						t.Errorf("%v: x modified", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDsymvQuadFormPanics(t *testing.T) {
+	a := make([]float64, 9)
+	x := make([]float64, 3)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"bad uplo", func() { impl.DsymvQuadForm('X', 3, a, 3, x, 1) }},
+		{"n < 0", func() { impl.DsymvQuadForm(blas.Upper, -1, a, 3, x, 1) }},
+		{"bad lda", func() { impl.DsymvQuadForm(blas.Upper, 3, a, 2, x, 1) }},
+		{"zero incX", func() { impl.DsymvQuadForm(blas.Upper, 3, a, 3, x, 0) }},
+		{"short a", func() { impl.DsymvQuadForm(blas.Upper, 3, a[:8], 3, x, 1) }},
+		{"short x", func() { impl.DsymvQuadForm(blas.Lower, 3, a, 3, x, 2) }},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%v: expected panic", test.name)
+		}
+	}
+}
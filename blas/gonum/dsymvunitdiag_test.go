@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsymvUnitDiag(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 10, 40} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, -3}, {-1, 1}} {
+				for _, ab := range []struct{ alpha, beta float64 }{{1, 0}, {-0.5, 2}, {0, 0.5}} {
+					name := fmt.Sprintf("ul=%c,n=%d,incX=%d,incY=%d,alpha=%v,beta=%v", ul, n, inc.x, inc.y, ab.alpha, ab.beta)
+					lda := n + 2
+					a := randomSymmetric(rnd, ul, n, lda)
+					x := randvec(n, inc.x, rnd)
+					y := randvec(n, inc.y, rnd)
+
+					// The diagonal is not referenced, so NaN there must not
+					// show up in the result of DsymvUnitDiag.
+					aUnit := make([]float64, len(a))
+					copy(aUnit, a)
+					for i := 0; i < n; i++ {
+						a[i*lda+i] = math.NaN()
+						aUnit[i*lda+i] = 1
+					}
+
+					want := make([]float64, len(y))
+					copy(want, y)
+					impl.Dsymv(ul, n, ab.alpha, aUnit, lda, x, inc.x, ab.beta, want, inc.y)
+
+					got := make([]float64, len(y))
+					copy(got, y)
+					impl.DsymvUnitDiag(ul, n, ab.alpha, a, lda, x, inc.x, ab.beta, got, inc.y)
+
+					if impl.useUnitarySymv(n, inc.x, inc.y) {
+						// Dsymv uses the Level 1 kernels, which may round
+						// differently.
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%s: result differs from Dsymv with unit diagonal", name)
+						}
+					} else if !floats.Same(got, want) {
+						t.Errorf("%s: result not bitwise equal to Dsymv with unit diagonal", name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// TestDsymvUplo checks that Dsymv gives the same result for a symmetric
+// matrix stored in its upper triangle and in its lower triangle, for both the
+// scalar loops and the unit increment paths.
+func TestDsymvUplo(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	th := DefaultThresholds()
+	th.SymvUnitary = 0
+	for _, bi := range []struct {
+		name string
+		impl Implementation
+	}{
+		{"default", impl},
+		{"unitary", Implementation{Thresholds: &th}},
+	} {
+		for _, n := range []int{1, 2, 3, 4, 7, 16, 33} {
+			for _, lda := range []int{n, n + 2} {
+				// Store the same symmetric matrix in the upper and the lower
+				// triangle with NaN in the triangle that must not be read.
+				upper := make([]float64, n*lda)
+				lower := make([]float64, n*lda)
+				for i := range upper {
+					upper[i] = math.NaN()
+					lower[i] = math.NaN()
+				}
+				for i := 0; i < n; i++ {
+					for j := i; j < n; j++ {
+						v := rnd.NormFloat64()
+						upper[i*lda+j] = v
+						lower[j*lda+i] = v
+					}
+				}
+				for _, incX := range []int{1, 2, -1, -3} {
+					for _, incY := range []int{1, 3, -2} {
+						for _, ab := range [][2]float64{{0, 0.5}, {1, 0}, {1, 1}, {-2.5, 0.5}} {
+							alpha, beta := ab[0], ab[1]
+							name := fmt.Sprintf("%v,n=%v,lda=%v,incX=%v,incY=%v,alpha=%v,beta=%v", bi.name, n, lda, incX, incY, alpha, beta)
+							x := randvec(n, incX, rnd)
+							y := randvec(n, incY, rnd)
+
+							yUpper := make([]float64, len(y))
+							copy(yUpper, y)
+							bi.impl.Dsymv(blas.Upper, n, alpha, upper, lda, x, incX, beta, yUpper, incY)
+
+							yLower := make([]float64, len(y))
+							copy(yLower, y)
+							bi.impl.Dsymv(blas.Lower, n, alpha, lower, lda, x, incX, beta, yLower, incY)
+
+							// The triangles are traversed in different orders, so
+							// the results may differ by summation order rounding.
+							tol := float64(4*n) * 0x1p-52
+							for i := range y {
+								if math.Abs(yUpper[i]-yLower[i]) > tol*math.Max(1, math.Abs(yLower[i])) {
+									t.Errorf("%v: upper and lower results differ at %v: %v != %v", name, i, yUpper[i], yLower[i])
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsyr2Order(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 7, 16} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {-3, -2}} {
+				name := fmt.Sprintf("ul=%c,n=%d,incX=%d,incY=%d", ul, n, inc.x, inc.y)
+				const alpha = 0.7
+				lda := n + 2
+				x := randvec(n, inc.x, rnd)
+				y := randvec(n, inc.y, rnd)
+				a := randomSymmetric(rnd, ul, n, lda)
+
+				// The reference rounds every operation separately, which
+				// is the order documented by Dsyr2.
+				want := make([]float64, len(a))
+				copy(want, a)
+				for i := 0; i < n; i++ {
+					xi := x[vecIndex(i, n, inc.x)]
+					yi := y[vecIndex(i, n, inc.y)]
+					jStart, jEnd := 0, i+1
+					if ul == blas.Upper {
+						jStart, jEnd = i, n
+					}
+					for j := jStart; j < jEnd; j++ {
+						xj := x[vecIndex(j, n, inc.x)]
+						yj := y[vecIndex(j, n, inc.y)]
+						want[i*lda+j] += float64(alpha * (float64(xi*yj) + float64(xj*yi)))
+					}
+				}
+
+				for run := 0; run < 2; run++ {
+					got := make([]float64, len(a))
+					copy(got, a)
+					impl.Dsyr2(ul, n, alpha, x, inc.x, y, inc.y, got, lda)
+					if !floats.Same(got, want) {
+						t.Errorf("%s: run %d: result not bitwise equal to the documented order", name, run)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDsyr2NoFusion(t *testing.T) {
+	// x[0]*y[1] = 1 - 2⁻⁶⁰ rounds to 1, which cancels x[1]*y[0] exactly.
+	// A fused multiply-add would instead leave -2⁻⁶⁰ in the off-diagonal.
+	x0 := 1 + math.Ldexp(1, -30)
+	y1 := 1 - math.Ldexp(1, -30)
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, inc := range []int{1, -2} {
+			n := 2
+			x := make([]float64, 1+(n-1)*abs(inc))
+			y := make([]float64, len(x))
+			x[vecIndex(0, n, inc)], x[vecIndex(1, n, inc)] = x0, -1
+			y[vecIndex(0, n, inc)], y[vecIndex(1, n, inc)] = 1, y1
+			a := make([]float64, 4)
+			impl.Dsyr2(ul, n, 1, x, inc, y, inc, a, 2)
+			off := a[1]
+			if ul == blas.Lower {
+				off = a[2]
+			}
+			if off != 0 {
+				t.Errorf("ul=%c,inc=%d: unexpected off-diagonal element: got %v, want 0", ul, inc, off)
+			}
+		}
+	}
+}
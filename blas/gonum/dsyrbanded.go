@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DsyrBanded performs the symmetric rank-one update
+//  A += alpha * x * xᵀ
+// restricted to the elements of A within k of the diagonal, where A is an
+// n×n symmetric matrix stored in dense format, and x is a vector.
+//
+// Only the elements of the ul triangle of A within k of the diagonal are
+// referenced and updated, so DsyrBanded returns the same result as Dsyr when
+// k >= n-1.
+func (Implementation) DsyrBanded(ul blas.Uplo, n, k int, alpha float64, x []float64, incX int, a []float64, lda int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 {
+		return
+	}
+
+	// The band can never be wider than the matrix.
+	k = min(k, n-1)
+
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	if ul == blas.Upper {
+		if incX == 1 {
+			for i := 0; i < n; i++ {
+				if tmp := alpha * x[i]; tmp != 0 {
+					u := min(n, i+k+1)
+					f64.AxpyUnitary(tmp, x[i:u], a[i*lda+i:i*lda+u])
+				}
+			}
+			return
+		}
+		ix := kx
+		for i := 0; i < n; i++ {
+			if tmp := alpha * x[ix]; tmp != 0 {
+				jx := ix
+				atmp := a[i*lda+i : i*lda+min(n, i+k+1)]
+				for j := range atmp {
+					atmp[j] += tmp * x[jx]
+					jx += incX
+				}
+			}
+			ix += incX
+		}
+		return
+	}
+	// Cases where a is lower triangular.
+	if incX == 1 {
+		for i := 0; i < n; i++ {
+			if tmp := alpha * x[i]; tmp != 0 {
+				l := max(0, i-k)
+				f64.AxpyUnitary(tmp, x[l:i+1], a[i*lda+l:i*lda+i+1])
+			}
+		}
+		return
+	}
+	ix := kx
+	for i := 0; i < n; i++ {
+		if tmp := alpha * x[ix]; tmp != 0 {
+			l := max(0, i-k)
+			jx := kx + l*incX
+			atmp := a[i*lda+l : i*lda+i+1]
+			for j := range atmp {
+				atmp[j] += tmp * x[jx]
+				jx += incX
+			}
+		}
+		ix += incX
+	}
+}
+
+// Dsyr2Banded performs the symmetric rank-two update
+//  A += alpha * x * yᵀ + alpha * y * xᵀ
+// restricted to the elements of A within k of the diagonal, where A is an
+// n×n symmetric matrix stored in dense format, x and y are vectors, and alpha
+// is a scalar.
+//
+// Only the elements of the ul triangle of A within k of the diagonal are
+// referenced and updated. Each element is updated with the same expression,
+// and so the same rounding, as Dsyr2 uses, so Dsyr2Banded returns the same
+// result as Dsyr2 when k >= n-1.
+func (Implementation) Dsyr2Banded(ul blas.Uplo, n, k int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 {
+		return
+	}
+
+	// The band can never be wider than the matrix.
+	k = min(k, n-1)
+
+	var kx, ky int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(n - 1) * incY
+	}
+	if ul == blas.Upper {
+		if incX == 1 && incY == 1 {
+			for i := 0; i < n; i++ {
+				xi := x[i]
+				yi := y[i]
+				u := min(n, i+k+1)
+				atmp := a[i*lda:]
+				for j := i; j < u; j++ {
+					atmp[j] += float64(alpha * (float64(xi*y[j]) + float64(x[j]*yi)))
+				}
+			}
+			return
+		}
+		ix := kx
+		iy := ky
+		for i := 0; i < n; i++ {
+			jx := ix
+			jy := iy
+			xi := x[ix]
+			yi := y[iy]
+			u := min(n, i+k+1)
+			atmp := a[i*lda:]
+			for j := i; j < u; j++ {
+				atmp[j] += float64(alpha * (float64(xi*y[jy]) + float64(x[jx]*yi)))
+				jx += incX
+				jy += incY
+			}
+			ix += incX
+			iy += incY
+		}
+		return
+	}
+	// Cases where a is lower triangular.
+	if incX == 1 && incY == 1 {
+		for i := 0; i < n; i++ {
+			xi := x[i]
+			yi := y[i]
+			atmp := a[i*lda:]
+			for j := max(0, i-k); j <= i; j++ {
+				atmp[j] += float64(alpha * (float64(xi*y[j]) + float64(x[j]*yi)))
+			}
+		}
+		return
+	}
+	ix := kx
+	iy := ky
+	for i := 0; i < n; i++ {
+		l := max(0, i-k)
+		jx := kx + l*incX
+		jy := ky + l*incY
+		xi := x[ix]
+		yi := y[iy]
+		atmp := a[i*lda:]
+		for j := l; j <= i; j++ {
+			atmp[j] += float64(alpha * (float64(xi*y[jy]) + float64(x[jx]*yi)))
+			jx += incX
+			jy += incY
+		}
+		ix += incX
+		iy += incY
+	}
+}
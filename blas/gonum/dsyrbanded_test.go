@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsyrBanded(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 5, 10} {
+			for _, k := range []int{0, 1, 2, n - 1, n + 3} {
+				if k < 0 {
+					continue
+				}
+				for _, inc := range [][2]int{{1, 1}, {2, 3}, {-3, 2}, {-2, -4}} {
+					for _, lda := range []int{n, n + 3} {
+						testDsyrBanded(t, rnd, ul, n, k, lda, inc[0], inc[1])
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDsyrBanded(t *testing.T, rnd *rand.Rand, ul blas.Uplo, n, k, lda, incX, incY int) {
+	const tol = 1e-14
+
+	a := randmat(n, n, lda, rnd)
+	x := randvec(n, incX, rnd)
+	y := randvec(n, incY, rnd)
+	alpha := 1.5
+
+	// Compute the full update and then restore the elements outside the
+	// band, which must be left untouched.
+	inBand := func(i, j int) bool {
+		return j-i <= k && i-j <= k
+	}
+	restore := func(dst []float64) {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if !inBand(i, j) {
+					dst[i*lda+j] = a[i*lda+j]
+				}
+			}
+		}
+	}
+	prefix := fmt.Sprintf("ul=%v,n=%v,k=%v,lda=%v,incX=%v,incY=%v", ul, n, k, lda, incX, incY)
+
+	want := make([]float64, len(a))
+	copy(want, a)
+	impl.Dsyr(ul, n, alpha, x, incX, want, lda)
+	restore(want)
+	got := make([]float64, len(a))
+	copy(got, a)
+	impl.DsyrBanded(ul, n, k, alpha, x, incX, got, lda)
+	if !floats.EqualApprox(got, want, tol) {
+		t.Errorf("%v: DsyrBanded result differs from band-restricted Dsyr\nwant %v\ngot  %v", prefix, want, got)
+	}
+
+	copy(want, a)
+	impl.Dsyr2(ul, n, alpha, x, incX, y, incY, want, lda)
+	restore(want)
+	copy(got, a)
+	impl.Dsyr2Banded(ul, n, k, alpha, x, incX, y, incY, got, lda)
+	// Dsyr2Banded uses the same expression as Dsyr2, so the results within
+	// the band agree exactly.
+	if !floats.Same(got, want) {
+		t.Errorf("%v: Dsyr2Banded result differs from band-restricted Dsyr2\nwant %v\ngot  %v", prefix, want, got)
+	}
+}
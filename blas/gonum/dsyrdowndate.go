@@ -0,0 +1,32 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DsyrDowndate performs the symmetric rank-one downdate
+//  A -= alpha * x * xᵀ
+// where A is an n×n symmetric matrix, x is a vector, and alpha is a scalar,
+// and reports whether the result has lost positive definiteness on its
+// diagonal.
+//
+// The downdate is performed by Dsyr with the scalar -alpha and is not aborted
+// when a diagonal element becomes non-positive. After the downdate, in the
+// manner of the LAPACK info parameter, DsyrDowndate returns the one-based
+// index i of the first diagonal element A[i-1][i-1] that is not positive, or
+// zero if there is none. A non-positive diagonal element shows that A is no
+// longer positive definite; a return value of zero does not guarantee that it
+// still is.
+func (impl Implementation) DsyrDowndate(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) (info int) {
+	impl.Dsyr(ul, n, -alpha, x, incX, a, lda)
+
+	for i := 0; i < n; i++ {
+		// The negated comparison also flags NaN diagonal elements.
+		if !(a[i*lda+i] > 0) {
+			return i + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsyrDowndate(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 8} {
+			for _, incX := range []int{1, -2} {
+				// bad holds the indices of the diagonal elements that the
+				// downdate makes non-positive.
+				badSets := [][]int{nil}
+				if n > 0 {
+					badSets = append(badSets, []int{0}, []int{n - 1})
+				}
+				if n/2 < n-1 {
+					badSets = append(badSets, []int{n / 2, n - 1})
+				}
+				for _, bad := range badSets {
+					const alpha = 0.5
+					lda := n + 1
+					// A = B * Bᵀ + n*I is symmetric positive definite.
+					b := randvec(n*n, 1, rnd)
+					a := make([]float64, n*lda)
+					if n > 0 {
+						impl.Dsyrk(ul, blas.NoTrans, n, n, 1, b, n, 0, a, lda)
+					}
+					for i := 0; i < n; i++ {
+						a[i*lda+i] += float64(n)
+					}
+					// A small downdate keeps A positive definite.
+					xd := make([]float64, n)
+					for i := range xd {
+						xd[i] = 0.1 * rnd.NormFloat64()
+					}
+					for k, i := range bad {
+						// Make the first offending diagonal element exactly
+						// zero and the others negative.
+						scale := 1.0
+						if k > 0 {
+							scale = 1.5
+						}
+						a[i*lda+i] = 2
+						xd[i] = 2 * scale
+					}
+					x := randvec(n, incX, rnd)
+					ix := 0
+					if incX < 0 {
+						ix = (1 - n) * incX
+					}
+					for i, v := range xd {
+						x[ix+i*incX] = v
+					}
+
+					want := make([]float64, len(a))
+					copy(want, a)
+					impl.Dsyr(ul, n, -alpha, x, incX, want, lda)
+
+					got := make([]float64, len(a))
+					copy(got, a)
+					info := impl.DsyrDowndate(ul, n, alpha, x, incX, got, lda)
+
+					name := fmt.Sprintf("ul=%c,n=%v,incX=%v,bad=%v", ul, n, incX, bad)
+					wantInfo := 0
+					if len(bad) > 0 {
+						wantInfo = bad[0] + 1
+					}
+					if info != wantInfo {
+						t.Errorf("%v: unexpected info: got %v, want %v", name, info, wantInfo)
+					}
+					if len(bad) > 0 && got[bad[0]*lda+bad[0]] != 0 {
+						t.Errorf("%v: unexpected diagonal element: got %v, want 0", name, got[bad[0]*lda+bad[0]])
+					}
+					if !floats.Same(got, want) {
+						t.Errorf("%v: result differs from Dsyr:\ngot  %v\nwant %v", name, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDsyrDowndateNaN(t *testing.T) {
+	a := []float64{
+		4, 1,
+		0, math.NaN(),
+	}
+	x := []float64{1, 1}
+	if info := impl.DsyrDowndate(blas.Upper, 2, 1, x, 1, a, 2); info != 2 {
+		t.Errorf("unexpected info for NaN diagonal: got %v, want 2", info)
+	}
+}
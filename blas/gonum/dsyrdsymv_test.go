@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// TestDsyrDsymvIdentity checks that Dsyr and Dsymv are consistent with each
+// other through the identity
+//  (A + alpha * x * xᵀ) * v = A * v + alpha * x * (xᵀ * v)
+// for a symmetric A and a probe vector v.
+func TestDsyrDsymvIdentity(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 5, 10, 17} {
+			for _, incX := range []int{1, 2, -3} {
+				for _, incV := range []int{1, -1, 2} {
+					for _, alpha := range []float64{0, 1, -2.5} {
+						name := fmt.Sprintf("ul=%c,n=%v,incX=%v,incV=%v,alpha=%v", ul, n, incX, incV, alpha)
+						lda := n + 3
+						a := randvec(n*lda, 1, rnd)
+						x := randvec(n, incX, rnd)
+						v := randvec(n, incV, rnd)
+
+						// want = A*v + alpha*x*(xᵀ*v).
+						want := make([]float64, n)
+						impl.Dsymv(ul, n, 1, a, lda, v, incV, 0, want, 1)
+						xv := impl.Ddot(n, x, incX, v, incV)
+						for i := range want {
+							want[i] += alpha * x[vecIndex(i, n, incX)] * xv
+						}
+
+						// got = (A + alpha*x*xᵀ)*v.
+						impl.Dsyr(ul, n, alpha, x, incX, a, lda)
+						got := make([]float64, n)
+						impl.Dsymv(ul, n, 1, a, lda, v, incV, 0, got, 1)
+
+						for i := range want {
+							if math.Abs(got[i]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+								t.Errorf("%v: identity violated at %v: got %v, want %v", name, i, got[i], want[i])
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
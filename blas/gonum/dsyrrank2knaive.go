@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DsyrRank2kNaive performs one of the symmetric rank 2k operations
+//  C = alpha * A * Bᵀ + alpha * B * Aᵀ + beta * C  if tA == blas.NoTrans
+//  C = alpha * Aᵀ * B + alpha * Bᵀ * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
+// where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
+// alpha and beta are scalars. Only the triangle of C specified by ul is
+// referenced and updated.
+//
+// DsyrRank2kNaive computes the same result as Dsyr2k as a sequence of k
+// symmetric rank-two updates by Dsyr2, one for each column of A and B if
+// tA == blas.NoTrans and one for each row otherwise. It is simple enough to be
+// checked by inspection and is intended as a reference for testing Dsyr2k,
+// not for performance.
+func (impl Implementation) DsyrRank2kNaive(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.Trans && tA != blas.NoTrans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	row, col := k, n
+	if tA == blas.NoTrans {
+		row, col = n, k
+	}
+	if lda < max(1, col) {
+		panic(badLdA)
+	}
+	if ldb < max(1, col) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(row-1)+col {
+		panic(shortA)
+	}
+	if len(b) < ldb*(row-1)+col {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+
+	// Form C = beta * C on the referenced triangle.
+	if beta != 1 {
+		for i := 0; i < n; i++ {
+			var ctmp []float64
+			if ul == blas.Upper {
+				ctmp = c[i*ldc+i : i*ldc+n]
+			} else {
+				ctmp = c[i*ldc : i*ldc+i+1]
+			}
+			for j := range ctmp {
+				if beta == 0 {
+					ctmp[j] = 0
+				} else {
+					ctmp[j] *= beta
+				}
+			}
+		}
+	}
+
+	if alpha == 0 || k == 0 {
+		return
+	}
+	for l := 0; l < k; l++ {
+		if tA == blas.NoTrans {
+			// Column l of A and B.
+			impl.Dsyr2(ul, n, alpha, a[l:], lda, b[l:], ldb, c, ldc)
+		} else {
+			// Row l of A and B.
+			impl.Dsyr2(ul, n, alpha, a[l*lda:], 1, b[l*ldb:], 1, c, ldc)
+		}
+	}
+}
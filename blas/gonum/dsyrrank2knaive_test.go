@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsyrRank2kNaive(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, n := range []int{0, 1, 2, 5, 9} {
+				for _, k := range []int{0, 1, 3, 7} {
+					row, col := k, n
+					if tA == blas.NoTrans {
+						row, col = n, k
+					}
+					for _, ld := range []int{0, 3} {
+						lda := max(1, col) + ld
+						ldb := max(1, col) + 2*ld
+						ldc := max(1, n) + ld
+						for _, ab := range [][2]float64{{0, 0}, {0, 0.5}, {1, 0}, {-1.5, 1}, {0.5, 2}} {
+							alpha, beta := ab[0], ab[1]
+							name := fmt.Sprintf("ul=%c,tA=%c,n=%v,k=%v,lda=%v,ldb=%v,ldc=%v,alpha=%v,beta=%v",
+								ul, tA, n, k, lda, ldb, ldc, alpha, beta)
+							a := randvec(max(0, lda*(row-1)+col), 1, rnd)
+							b := randvec(max(0, ldb*(row-1)+col), 1, rnd)
+
+							// The elements of C outside the referenced triangle
+							// are NaN. For beta == 0 so are the elements inside
+							// it, which must then be overwritten without being
+							// read.
+							c := make([]float64, max(0, ldc*(n-1)+n))
+							for i := range c {
+								c[i] = math.NaN()
+							}
+							for i := 0; i < n; i++ {
+								for j := 0; j < n; j++ {
+									if beta != 0 && ((ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i)) {
+										c[i*ldc+j] = rnd.NormFloat64()
+									}
+								}
+							}
+
+							// Form the dense reference with Dgemm on the full
+							// symmetric matrix.
+							want := make([]float64, n*n)
+							for i := 0; i < n; i++ {
+								for j := 0; j < n; j++ {
+									if beta == 0 {
+										continue
+									}
+									if (ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i) {
+										want[i*n+j] = c[i*ldc+j]
+									} else {
+										want[i*n+j] = c[j*ldc+i]
+									}
+								}
+							}
+							if n > 0 {
+								tB := blas.Trans
+								if tA != blas.NoTrans {
+									tB = blas.NoTrans
+								}
+								impl.Dgemm(tA, tB, n, n, k, alpha, a, lda, b, ldb, beta, want, n)
+								impl.Dgemm(tA, tB, n, n, k, alpha, b, ldb, a, lda, 1, want, n)
+							}
+
+							got := make([]float64, len(c))
+							copy(got, c)
+							impl.DsyrRank2kNaive(ul, tA, n, k, alpha, a, lda, b, ldb, beta, got, ldc)
+
+							dsyr2k := make([]float64, len(c))
+							copy(dsyr2k, c)
+							impl.Dsyr2k(ul, tA, n, k, alpha, a, lda, b, ldb, beta, dsyr2k, ldc)
+
+							for i := 0; i < n; i++ {
+								for j := 0; j < n; j++ {
+									g := got[i*ldc+j]
+									if (ul == blas.Upper && j < i) || (ul == blas.Lower && j > i) {
+										if !math.IsNaN(g) {
+											t.Errorf("%v: unexpected modification of C[%v][%v] outside the triangle", name, i, j)
+										}
+										continue
+									}
+									if !floats.EqualWithinAbsOrRel(g, want[i*n+j], tol, tol) {
+										t.Errorf("%v: unexpected C[%v][%v]: want %v, got %v", name, i, j, want[i*n+j], g)
+									}
+									if !floats.EqualWithinAbsOrRel(g, dsyr2k[i*ldc+j], tol, tol) {
+										t.Errorf("%v: C[%v][%v] differs from Dsyr2k: want %v, got %v", name, i, j, dsyr2k[i*ldc+j], g)
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DsyrRidge performs the symmetric rank-one update with a ridge term
+//  A += alpha * x * xᵀ + lambda * I
+// where A is an n×n symmetric matrix, x is a vector, and alpha and lambda are
+// scalars. The rank-one update is done row by row as in Dsyr and lambda is
+// added to the diagonal element of each row in the same sweep, so the result
+// is the same as that of Dsyr followed by adding lambda to the diagonal.
+func (Implementation) DsyrRidge(ul blas.Uplo, n int, alpha float64, x []float64, incX int, lambda float64, a []float64, lda int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && lambda == 0 {
+		return
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	ix := kx
+	for i := 0; i < n; i++ {
+		tmp := x[ix] * alpha
+		if tmp != 0 {
+			if ul == blas.Upper {
+				// Update A[i][i:n].
+				jx := ix
+				atmp := a[i*lda+i : i*lda+n]
+				for j := range atmp {
+					atmp[j] += x[jx] * tmp
+					jx += incX
+				}
+			} else {
+				// Update A[i][0:i+1].
+				jx := kx
+				atmp := a[i*lda : i*lda+i+1]
+				for j := range atmp {
+					atmp[j] += tmp * x[jx]
+					jx += incX
+				}
+			}
+		}
+		a[i*lda+i] += lambda
+		ix += incX
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDsyrRidge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 7, 16} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, incX := range []int{1, 2, -1, -3} {
+					for _, al := range [][2]float64{{0, 0}, {0, 0.5}, {1.5, 0}, {-0.75, 2}} {
+						alpha, lambda := al[0], al[1]
+						name := fmt.Sprintf("ul=%v,n=%v,lda=%v,incX=%v,alpha=%v,lambda=%v", ul, n, lda, incX, alpha, lambda)
+						// The unreferenced triangle and the padding are NaN
+						// and must stay so.
+						a := randomSymmetric(rnd, ul, n, lda)
+						x := randvec(n, incX, rnd)
+
+						want := make([]float64, len(a))
+						copy(want, a)
+						impl.Dsyr(ul, n, alpha, x, incX, want, lda)
+						for i := 0; i < n; i++ {
+							want[i*lda+i] += lambda
+						}
+
+						got := make([]float64, len(a))
+						copy(got, a)
+						impl.DsyrRidge(ul, n, alpha, x, incX, lambda, got, lda)
+						if !floats.Same(got, want) {
+							t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
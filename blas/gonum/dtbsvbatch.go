@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DtbsvBatch solves count independent systems of equations
+//  A_s * x_s = b_s   if tA == blas.NoTrans
+//  A_sᵀ * x_s = b_s  if tA == blas.Trans or blas.ConjTrans
+// for s = 0, ..., count-1, where each A_s is an n×n triangular band matrix
+// with k+1 diagonals stored in band form as for Dtbsv, and x_s and b_s are
+// vectors with increment incX.
+//
+// A_s is stored in a[s*batchStride:] with leading dimension lda, and at entry
+// x_s contains b_s in x[s*xStride:]. The solutions are stored in-place into x.
+// batchStride may be zero, in which case every system has the same matrix.
+// xStride must be at least 1+(n-1)*|incX| when count > 1, so that the
+// vectors of different systems do not overlap.
+//
+// The arguments are checked once for the whole batch, and each system is
+// then solved by Dtbsv, so the result is the same as that of count calls to
+// Dtbsv.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (impl Implementation) DtbsvBatch(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda, batchStride int, x []float64, incX, xStride, count int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if batchStride < 0 {
+		panic(batchStrideLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if count < 0 {
+		panic(countLT0)
+	}
+	lenX := 1 + (n-1)*incX
+	if incX < 0 {
+		lenX = 1 + (1-n)*incX
+	}
+	if count > 1 && xStride < lenX {
+		panic(badXStride)
+	}
+
+	// Quick return if possible.
+	if n == 0 || count == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < (count-1)*batchStride+lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if len(x) < (count-1)*xStride+lenX {
+		panic(shortX)
+	}
+
+	for s := 0; s < count; s++ {
+		impl.Dtbsv(ul, tA, d, n, k, a[s*batchStride:], lda, x[s*xStride:], incX)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtbsvBatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, nk := range []struct{ n, k int }{{1, 0}, {3, 1}, {10, 3}, {5, 7}} {
+					for _, incX := range []int{1, -2} {
+						for _, count := range []int{0, 1, 4} {
+							// A batch stride of zero shares one matrix
+							// between all systems.
+							for _, shared := range []bool{false, true} {
+								n, k := nk.n, nk.k
+								name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%d,k=%d,incX=%d,count=%d,shared=%t",
+									ul, tA, d, n, k, incX, count, shared)
+								lda := k + 2
+								batchStride := n*lda + 3
+								if shared {
+									batchStride = 0
+								}
+								var a []float64
+								for s := 0; s < max(1, count); s++ {
+									a = append(a, randomTriangularBand(rnd, ul, n, k, lda)...)
+									a = append(a, nanSlice(3)...)
+								}
+								xStride := 1 + (n-1)*abs(incX) + 2
+								x := nanSlice(count * xStride)
+								for s := 0; s < count; s++ {
+									copy(x[s*xStride:], randvec(n, incX, rnd))
+								}
+
+								want := make([]float64, len(x))
+								copy(want, x)
+								for s := 0; s < count; s++ {
+									impl.Dtbsv(ul, tA, d, n, k, a[s*batchStride:], lda, want[s*xStride:], incX)
+								}
+								got := make([]float64, len(x))
+								copy(got, x)
+								impl.DtbsvBatch(ul, tA, d, n, k, a, lda, batchStride, got, incX, xStride, count)
+								if !floats.Same(got, want) {
+									t.Errorf("%s: result differs from individual Dtbsv calls", name)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDtbsvBatchPanics(t *testing.T) {
+	const n, k, lda = 4, 1, 2
+	a := make([]float64, 3*n*lda)
+	x := make([]float64, 3*n)
+	for _, test := range []struct {
+		name                        string
+		batchStride, xStride, count int
+		a, x                        []float64
+		want                        string
+	}{
+		{name: "count", batchStride: n * lda, xStride: n, count: -1, a: a, x: x, want: countLT0},
+		{name: "batchStride", batchStride: -1, xStride: n, count: 3, a: a, x: x, want: batchStrideLT0},
+		{name: "overlapping x", batchStride: n * lda, xStride: n - 1, count: 3, a: a, x: x, want: badXStride},
+		{name: "short a", batchStride: n*lda + 1, xStride: n, count: 3, a: a, x: x, want: shortA},
+		{name: "short x", batchStride: n * lda, xStride: n + 1, count: 3, a: a, x: x, want: shortX},
+	} {
+		p := panicValue(func() {
+			impl.DtbsvBatch(blas.Upper, blas.NoTrans, blas.NonUnit, n, k, test.a, lda, test.batchStride, test.x, 1, test.xStride, test.count)
+		})
+		if p != test.want {
+			t.Errorf("%s: unexpected panic: got %v, want %q", test.name, p, test.want)
+		}
+	}
+
+	// A single system may use any x stride.
+	impl.DtbsvBatch(blas.Upper, blas.NoTrans, blas.Unit, n, k, a, lda, 0, x, 1, 0, 1)
+}
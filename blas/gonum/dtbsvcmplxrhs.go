@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DtbsvCmplxRHS solves one of the systems of equations
+//  A * x = b   if tA == blas.NoTrans
+//  Aᵀ * x = b  if tA == blas.Trans or tA == blas.ConjTrans
+// where A is a real n×n triangular band matrix with k+1 diagonals in the
+// storage used by Dtbsv, and x and b are complex vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// The real and imaginary parts of x are solved in a single pass over A with
+// separate accumulators, without complex multiplication, so each part of the
+// result is the same as that of Dtbsv applied to it alone.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (Implementation) DtbsvCmplxRHS(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []complex128, incX int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	nonUnit := d == blas.NonUnit
+	trans := tA != blas.NoTrans
+
+	// The solution element i depends on the elements j > i if A is upper
+	// triangular and not transposed, or lower triangular and transposed, and
+	// on the elements j < i otherwise. band returns the index in a of the
+	// element of op(A) in row i and column j.
+	backward := (ul == blas.Upper) != trans
+	band := func(i, j int) int {
+		r, c := i, j
+		if trans {
+			r, c = j, i
+		}
+		if ul == blas.Upper {
+			return r*lda + c - r
+		}
+		return r*lda + k + c - r
+	}
+	for l := 0; l < n; l++ {
+		i := l
+		jStart, jEnd := max(0, i-k), i
+		if backward {
+			i = n - 1 - l
+			jStart, jEnd = i+1, min(n, i+k+1)
+		}
+		var sumRe, sumIm float64
+		jx := kx + jStart*incX
+		for j := jStart; j < jEnd; j++ {
+			v := a[band(i, j)]
+			sumRe += real(x[jx]) * v
+			sumIm += imag(x[jx]) * v
+			jx += incX
+		}
+		ix := kx + i*incX
+		re := real(x[ix]) - sumRe
+		im := imag(x[ix]) - sumIm
+		if nonUnit {
+			diag := a[band(i, i)]
+			re /= diag
+			im /= diag
+		}
+		x[ix] = complex(re, im)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtbsvCmplxRHS(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 5, 12} {
+					for _, k := range []int{0, 1, 3, 15} {
+						for _, incX := range []int{1, 3, -2} {
+							name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%d,k=%d,incX=%d", ul, tA, d, n, k, incX)
+							lda := k + 2
+							a := randomTriangularBand(rnd, ul, n, k, lda)
+
+							x := make([]complex128, 1+(n-1)*abs(incX))
+							re := make([]float64, len(x))
+							im := make([]float64, len(x))
+							for i := range x {
+								re[i] = rnd.NormFloat64()
+								im[i] = rnd.NormFloat64()
+								x[i] = complex(re[i], im[i])
+							}
+
+							impl.Dtbsv(ul, tA, d, n, k, a, lda, re, incX)
+							impl.Dtbsv(ul, tA, d, n, k, a, lda, im, incX)
+							impl.DtbsvCmplxRHS(ul, tA, d, n, k, a, lda, x, incX)
+
+							gotRe := make([]float64, len(x))
+							gotIm := make([]float64, len(x))
+							for i, v := range x {
+								gotRe[i] = real(v)
+								gotIm[i] = imag(v)
+							}
+							if !floats.Same(gotRe, re) {
+								t.Errorf("%s: real part differs from Dtbsv: got %v, want %v", name, gotRe, re)
+							}
+							if !floats.Same(gotIm, im) {
+								t.Errorf("%s: imaginary part differs from Dtbsv: got %v, want %v", name, gotIm, im)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
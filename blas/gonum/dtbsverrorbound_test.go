@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// bigTriSolve returns the solution of the n×n triangular system T * x = b
+// with T stored densely in t with leading dimension n, computed in extended
+// precision. lower specifies whether T is lower triangular.
+func bigTriSolve(lower bool, n int, t, b []float64) []float64 {
+	const prec = 1024
+	x := make([]*big.Float, n)
+	solve := func(i int, js []int) {
+		sum := new(big.Float).SetPrec(prec).SetFloat64(b[i])
+		for _, j := range js {
+			p := new(big.Float).SetPrec(prec).SetFloat64(t[i*n+j])
+			p.Mul(p, x[j])
+			sum.Sub(sum, p)
+		}
+		x[i] = sum.Quo(sum, new(big.Float).SetPrec(prec).SetFloat64(t[i*n+i]))
+	}
+	if lower {
+		for i := 0; i < n; i++ {
+			js := make([]int, 0, i)
+			for j := 0; j < i; j++ {
+				js = append(js, j)
+			}
+			solve(i, js)
+		}
+	} else {
+		for i := n - 1; i >= 0; i-- {
+			js := make([]int, 0, n-i)
+			for j := i + 1; j < n; j++ {
+				js = append(js, j)
+			}
+			solve(i, js)
+		}
+	}
+	res := make([]float64, n)
+	for i, v := range x {
+		res[i], _ = v.Float64()
+	}
+	return res
+}
+
+func TestDtbsvErrorBound(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 5, 10, 30} {
+					for _, k := range []int{0, 1, 3, n + 1} {
+						for _, incX := range []int{1, -2} {
+							for _, scale := range []float64{1, 20} {
+								name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%v,k=%v,incX=%v,scale=%v", ul, tA, d, n, k, incX, scale)
+								testDtbsvErrorBound(t, name, rnd, ul, tA, d, n, k, incX, scale)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDtbsvErrorBound(t *testing.T, name string, rnd *rand.Rand, ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k, incX int, scale float64) {
+	lda := k + 2
+	a := make([]float64, lda*n)
+	for i := range a {
+		a[i] = math.NaN()
+	}
+	// Fill the band with off-diagonal elements of magnitude up to scale so
+	// that large scale values give ill-conditioned matrices with noticeable
+	// rounding errors in the solution.
+	dense := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		var lo, hi int
+		if ul == blas.Upper {
+			lo, hi = i, min(n-1, i+k)
+		} else {
+			lo, hi = max(0, i-k), i
+		}
+		for j := lo; j <= hi; j++ {
+			v := scale * (2*rnd.Float64() - 1)
+			if j == i {
+				v = 0.5 + rnd.Float64()
+				if rnd.Intn(2) == 0 {
+					v = -v
+				}
+			}
+			if ul == blas.Upper {
+				a[i*lda+j-i] = v
+			} else {
+				a[i*lda+k+j-i] = v
+			}
+			if j == i && d == blas.Unit {
+				v = 1
+			}
+			if tA == blas.NoTrans {
+				dense[i*n+j] = v
+			} else {
+				dense[j*n+i] = v
+			}
+		}
+	}
+	lower := (ul == blas.Lower) == (tA == blas.NoTrans)
+
+	b := make([]float64, n)
+	for i := range b {
+		b[i] = rnd.NormFloat64()
+	}
+	want := bigTriSolve(lower, n, dense, b)
+
+	x := randvec(n, incX, rnd)
+	ix := 0
+	if incX < 0 {
+		ix = (1 - n) * incX
+	}
+	for i := range b {
+		x[ix+i*incX] = b[i]
+	}
+	bound := impl.DtbsvErrorBound(ul, tA, d, n, k, a, lda, x, incX)
+	if len(bound) != n {
+		t.Errorf("%v: unexpected length of bound: want %v, got %v", name, n, len(bound))
+		return
+	}
+
+	xDtbsv := make([]float64, len(x))
+	for i := range b {
+		xDtbsv[ix+i*incX] = b[i]
+	}
+	impl.Dtbsv(ul, tA, d, n, k, a, lda, xDtbsv, incX)
+
+	for i := 0; i < n; i++ {
+		got := x[ix+i*incX]
+		if got != xDtbsv[ix+i*incX] {
+			t.Errorf("%v: solution differs from Dtbsv at %v: want %v, got %v", name, i, xDtbsv[ix+i*incX], got)
+		}
+		if !(bound[i] >= 0) {
+			t.Errorf("%v: invalid bound at %v: %v", name, i, bound[i])
+			continue
+		}
+		// Allow for the second-order rounding errors in forming the bound.
+		if err := math.Abs(got - want[i]); err > bound[i]*(1+1e-8) {
+			t.Errorf("%v: bound at %v is not an upper bound: error %v, bound %v", name, i, err, bound[i])
+		}
+	}
+}
+
+func TestDtbsvErrorBoundZero(t *testing.T) {
+	// A diagonal system with exactly representable quotients has no
+	// rounding error, but the bound must still be non-negative.
+	a := []float64{2, 4, 8}
+	x := []float64{1, 2, 4}
+	bound := impl.DtbsvErrorBound(blas.Upper, blas.NoTrans, blas.NonUnit, 3, 0, a, 1, x, 1)
+	for i, want := range []float64{0.5, 0.5, 0.5} {
+		if x[i] != want {
+			t.Errorf("unexpected solution at %v: want %v, got %v", i, want, x[i])
+		}
+		if bound[i] < 0 || bound[i] > 1e-15 {
+			t.Errorf("unexpected bound at %v: %v", i, bound[i])
+		}
+	}
+	if bound := impl.DtbsvErrorBound(blas.Upper, blas.NoTrans, blas.NonUnit, 0, 0, nil, 1, nil, 1); bound != nil {
+		t.Errorf("unexpected bound for n=0: %v", bound)
+	}
+}
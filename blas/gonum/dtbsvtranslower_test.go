@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// TestDtbsvTransLowerStrided checks the strided transposed lower branch of
+// Dtbsv against Dtrsv on the same matrix in dense storage, with a focus on
+// negative increments.
+func TestDtbsvTransLowerStrided(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 8} {
+		for _, k := range []int{0, 1, 2, 4, 9} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, tA := range []blas.Transpose{blas.Trans, blas.ConjTrans} {
+					lda := k + 1
+					dense := randomTriangular(rnd, blas.Lower, n, n)
+					band := nanSlice(n * lda)
+					for i := 0; i < n; i++ {
+						for j := 0; j < i; j++ {
+							if j < i-k {
+								dense[i*n+j] = 0
+							} else {
+								band[i*lda+k+j-i] = dense[i*n+j]
+							}
+						}
+						band[i*lda+k] = dense[i*n+i]
+						if d == blas.Unit {
+							// The diagonal must not be referenced.
+							band[i*lda+k] = math.NaN()
+						}
+					}
+					for _, incX := range []int{-2, -1, -3, 2} {
+						name := fmt.Sprintf("n=%v,k=%v,d=%v,tA=%v,incX=%v", n, k, d, tA, incX)
+						x := randvec(n, incX, rnd)
+
+						want := make([]float64, len(x))
+						copy(want, x)
+						impl.Dtrsv(blas.Lower, tA, d, n, dense, n, want, incX)
+
+						got := make([]float64, len(x))
+						copy(got, x)
+						impl.Dtbsv(blas.Lower, tA, d, n, k, band, lda, got, incX)
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%v: result differs from dense solve\nwant %v\ngot  %v", name, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DtrmvOffset performs one of the matrix-vector operations
+//  x = T * x   if tA == blas.NoTrans
+//  x = Tᵀ * x  if tA == blas.Trans or blas.ConjTrans
+// where T is the n×n matrix formed by the elements A[i][j] of the n×n matrix A
+// with j-i >= d, the remaining elements of T being zero, and x is a vector.
+// Only the elements of A on and above the d-th diagonal are referenced.
+//
+// With d == 0, T is the upper triangle of A as used by Dtrmv with blas.Upper
+// and blas.NonUnit, and with d == 1 it is the strictly upper triangle. A
+// negative d includes -d sub-diagonals. For d >= 0 x is updated in place as
+// in Dtrmv; for d < 0 the result depends on elements of x on both sides of
+// the diagonal, so x is first copied to a temporary.
+func (impl Implementation) DtrmvOffset(tA blas.Transpose, n, d int, a []float64, lda int, x []float64, incX int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+
+	// src holds the vector that is read. For d >= 0 each element of the
+	// result depends only on elements of x that are not overwritten before
+	// it is formed, so x is read directly.
+	src, incS, ks := x, incX, kx
+	if d < 0 {
+		src = make([]float64, n)
+		impl.Dcopy(n, x, incX, src, 1)
+		incS, ks = 1, 0
+	}
+
+	if tA == blas.NoTrans {
+		// Form the elements of x in increasing order.
+		ix := kx
+		for i := 0; i < n; i++ {
+			j0 := max(0, i+d)
+			if j0 >= n {
+				x[ix] = 0
+			} else {
+				x[ix] = f64.DotInc(src, a[i*lda+j0:i*lda+n], uintptr(n-j0), uintptr(incS), 1, uintptr(ks+j0*incS), 0)
+			}
+			ix += incX
+		}
+		return
+	}
+	// Form the elements of x in decreasing order. Element j is the dot
+	// product of column j of A, down to row j-d, with x.
+	ix := kx + (n-1)*incX
+	for j := n - 1; j >= 0; j-- {
+		i1 := min(n-1, j-d)
+		if i1 < 0 {
+			x[ix] = 0
+		} else {
+			x[ix] = f64.DotInc(src, a[j:], uintptr(i1+1), uintptr(incS), uintptr(lda), uintptr(ks), 0)
+		}
+		ix -= incX
+	}
+}
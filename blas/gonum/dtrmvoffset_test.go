@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtrmvOffset(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		for _, d := range []int{-n - 1, -n + 1, -2, -1, 0, 1, 2, n - 1, n} {
+			for _, lda := range []int{n, n + 3} {
+				// Elements below the d-th diagonal are NaN, so reading
+				// them shows up in the result.
+				a := randomDense(rnd, n, n, lda)
+				dense := make([]float64, n*n)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if j-i >= d {
+							dense[i*n+j] = a[i*lda+j]
+						} else {
+							a[i*lda+j] = math.NaN()
+						}
+					}
+				}
+				for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, incX := range []int{1, 2, -1, -3} {
+						name := fmt.Sprintf("n=%v,d=%v,lda=%v,tA=%v,incX=%v", n, d, lda, tA, incX)
+						x := randvec(n, incX, rnd)
+
+						want := make([]float64, len(x))
+						copy(want, x)
+						impl.Dgemv(tA, n, n, 1, dense, n, x, incX, 0, want, incX)
+
+						got := make([]float64, len(x))
+						copy(got, x)
+						impl.DtrmvOffset(tA, n, d, a, lda, got, incX)
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDtrmvOffsetDtrmv(t *testing.T) {
+	// Offset zero is the non-unit upper triangle used by Dtrmv.
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 9} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			a := randomTriangular(rnd, blas.Upper, n, n)
+			x := randvec(n, 1, rnd)
+			want := make([]float64, n)
+			copy(want, x)
+			impl.Dtrmv(blas.Upper, tA, blas.NonUnit, n, a, n, want, 1)
+			impl.DtrmvOffset(tA, n, 0, a, n, x, 1)
+			if !floats.EqualApprox(x, want, 1e-14) {
+				t.Errorf("n=%v,tA=%v: result differs from Dtrmv\nwant %v\ngot  %v", n, tA, want, x)
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DtrmvProbe performs one of the matrix-vector operations
+//  x = A * x   if tA == blas.NoTrans
+//  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+// where A is an n×n triangular matrix, and x is a vector, and returns the
+// largest magnitude of the intermediate values of the product.
+//
+// Each element of the result is accumulated starting from its diagonal term,
+// followed by the off-diagonal terms in increasing column order for
+// tA == blas.NoTrans and increasing row order otherwise. maxAbs is the largest
+// absolute value of these partial sums over all elements, including the final
+// values. Growth of maxAbs relative to the norm of the result indicates
+// cancellation, which is used in the estimation of triangular condition
+// numbers. The result may differ in the last bits from that of Dtrmv.
+func (Implementation) DtrmvProbe(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) (maxAbs float64) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = -(n - 1) * incX
+	}
+	nonUnit := d == blas.NonUnit
+	trans := tA != blas.NoTrans
+
+	// Element i of the result depends on the elements j > i of x if A is
+	// upper triangular and not transposed, or lower triangular and
+	// transposed, so the elements are then computed in increasing order, and
+	// otherwise in decreasing order, so that x can be overwritten.
+	increasing := (ul == blas.Upper) != trans
+	for k := 0; k < n; k++ {
+		i := k
+		if !increasing {
+			i = n - 1 - k
+		}
+		ix := kx + i*incX
+		sum := x[ix]
+		if nonUnit {
+			sum *= a[i*lda+i]
+		}
+		if v := math.Abs(sum); v > maxAbs {
+			maxAbs = v
+		}
+		jStart, jEnd := i+1, n
+		if !increasing {
+			jStart, jEnd = 0, i
+		}
+		jx := kx + jStart*incX
+		for j := jStart; j < jEnd; j++ {
+			if trans {
+				sum += a[j*lda+i] * x[jx]
+			} else {
+				sum += a[i*lda+j] * x[jx]
+			}
+			if v := math.Abs(sum); v > maxAbs {
+				maxAbs = v
+			}
+			jx += incX
+		}
+		x[ix] = sum
+	}
+	return maxAbs
+}
@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtrmvProbe(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 9} {
+					for _, incX := range []int{1, -2} {
+						name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%d,incX=%d", ul, tA, d, n, incX)
+						lda := n + 1
+						a := randomTriangular(rnd, ul, n, lda)
+						x := randvec(n, incX, rnd)
+
+						// Form op(A) * x from a copy of x, accumulating each
+						// element in the documented order.
+						at := func(i, j int) float64 {
+							if tA == blas.NoTrans {
+								return a[i*lda+j]
+							}
+							return a[j*lda+i]
+						}
+						inTriangle := func(i, j int) bool {
+							if (ul == blas.Upper) == (tA == blas.NoTrans) {
+								return j > i
+							}
+							return j < i
+						}
+						want := make([]float64, len(x))
+						copy(want, x)
+						var wantMax float64
+						for i := 0; i < n; i++ {
+							sum := x[vecIndex(i, n, incX)]
+							if d == blas.NonUnit {
+								sum *= at(i, i)
+							}
+							wantMax = math.Max(wantMax, math.Abs(sum))
+							for j := 0; j < n; j++ {
+								if inTriangle(i, j) {
+									sum += at(i, j) * x[vecIndex(j, n, incX)]
+									wantMax = math.Max(wantMax, math.Abs(sum))
+								}
+							}
+							want[vecIndex(i, n, incX)] = sum
+						}
+
+						got := make([]float64, len(x))
+						copy(got, x)
+						gotMax := impl.DtrmvProbe(ul, tA, d, n, a, lda, got, incX)
+						if !floats.Same(got, want) {
+							t.Errorf("%s: unexpected result: got %v, want %v", name, got, want)
+						}
+						if gotMax != wantMax {
+							t.Errorf("%s: unexpected maximum intermediate: got %v, want %v", name, gotMax, wantMax)
+						}
+
+						dtrmv := make([]float64, len(x))
+						copy(dtrmv, x)
+						impl.Dtrmv(ul, tA, d, n, a, lda, dtrmv, incX)
+						if !floats.EqualApprox(got, dtrmv, tol) {
+							t.Errorf("%s: result differs from Dtrmv", name)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDtrmvProbeCancellation(t *testing.T) {
+	// The first element of A * x is -1000 + 1000 = 0, so the largest
+	// intermediate is larger than any element of the result.
+	a := []float64{
+		1, 1000,
+		0, 1,
+	}
+	x := []float64{-1000, 1}
+	got := impl.DtrmvProbe(blas.Upper, blas.NoTrans, blas.NonUnit, 2, a, 2, x, 1)
+	if got != 1000 {
+		t.Errorf("unexpected maximum intermediate: got %v, want 1000", got)
+	}
+	if x[0] != 0 || x[1] != 1 {
+		t.Errorf("unexpected result: got %v, want [0 1]", x)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// DtrsvBatchPar solves one of the systems of equations
+//  A * X = B   if tA == blas.NoTrans
+//  Aᵀ * X = B  if tA == blas.Trans or blas.ConjTrans
+// where A is an n×n triangular matrix, and X and B are n×nrhs matrices, by
+// solving for each of the nrhs columns of B in parallel.
+//
+// At entry to the function, b contains the values of B with leading dimension
+// ldb, and the result is stored in-place into b.
+//
+// The columns are divided between at most GOMAXPROCS goroutines. Each
+// goroutine gathers its columns, in blocks of at most blockSize, into
+// contiguous storage and calls Dtrsv with unit increment for each of them
+// before scattering the solutions back into b. Every column is solved
+// independently, so the result does not depend on the number of goroutines.
+// A panic in any of the goroutines is propagated to the caller once all of
+// them have finished.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (impl Implementation) DtrsvBatchPar(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, b []float64, ldb int, nrhs int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if nrhs < 0 {
+		panic(nrhsLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if ldb < max(1, nrhs) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+nrhs {
+		panic(shortB)
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), nrhs)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		panicked bool
+		perr     interface{}
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		// Worker w solves the columns in [j0, j1).
+		j0 := w * nrhs / workers
+		j1 := (w + 1) * nrhs / workers
+		go func(j0, j1 int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					if !panicked {
+						panicked, perr = true, r
+					}
+					mu.Unlock()
+				}
+			}()
+			work := make([]float64, n*min(blockSize, j1-j0))
+			for jb := j0; jb < j1; jb += blockSize {
+				nb := min(blockSize, j1-jb)
+				// Gather the columns of the block so that each is
+				// contiguous, reading B a row at a time.
+				for i := 0; i < n; i++ {
+					for k, v := range b[i*ldb+jb : i*ldb+jb+nb] {
+						work[k*n+i] = v
+					}
+				}
+				for k := 0; k < nb; k++ {
+					impl.Dtrsv(ul, tA, d, n, a, lda, work[k*n:(k+1)*n], 1)
+				}
+				for i := 0; i < n; i++ {
+					brow := b[i*ldb+jb : i*ldb+jb+nb]
+					for k := range brow {
+						brow[k] = work[k*n+i]
+					}
+				}
+			}
+		}(j0, j1)
+	}
+	wg.Wait()
+	if panicked {
+		panic(perr)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtrsvBatchPar(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 3, 17} {
+					for _, nrhs := range []int{1, 2, 5, 33, 2*blockSize + 3} {
+						lda := n + 3
+						ldb := nrhs + 2
+						a := randomTriangular(rnd, ul, n, lda)
+						b := randomDense(rnd, n, nrhs, ldb)
+
+						want := make([]float64, len(b))
+						copy(want, b)
+						col := make([]float64, n)
+						for j := 0; j < nrhs; j++ {
+							for i := range col {
+								col[i] = want[i*ldb+j]
+							}
+							impl.Dtrsv(ul, tA, d, n, a, lda, col, 1)
+							for i, v := range col {
+								want[i*ldb+j] = v
+							}
+						}
+
+						// The result must not depend on the number of
+						// goroutines the columns are divided between.
+						for _, procs := range []int{1, 3, 8} {
+							runtime.GOMAXPROCS(procs)
+							name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%d,nrhs=%d,procs=%d", ul, tA, d, n, nrhs, procs)
+							got := make([]float64, len(b))
+							copy(got, b)
+							impl.DtrsvBatchPar(ul, tA, d, n, a, lda, got, ldb, nrhs)
+							if !floats.Same(got, want) {
+								t.Errorf("%s: result differs from serial column solves", name)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDtrsvBatchPar(b *testing.B) {
+	const n = 200
+	rnd := rand.New(rand.NewSource(1))
+	a := randomTriangular(rnd, blas.Lower, n, n)
+	for _, nrhs := range []int{1, 4, 16, 64, 256} {
+		rhs := randomDense(rnd, n, nrhs, nrhs)
+		work := make([]float64, len(rhs))
+		b.Run(fmt.Sprintf("nrhs=%d/serial", nrhs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(work, rhs)
+				for j := 0; j < nrhs; j++ {
+					impl.Dtrsv(blas.Lower, blas.NoTrans, blas.NonUnit, n, a, n, work[j:], nrhs)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("nrhs=%d/par", nrhs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(work, rhs)
+				impl.DtrsvBatchPar(blas.Lower, blas.NoTrans, blas.NonUnit, n, a, n, work, nrhs, nrhs)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// TestDtrsvMatrixColumn checks that Dtrsv solves in place into a column of a
+// larger row-major matrix, that is with |incX| equal to the leading dimension
+// of the matrix, and agrees with a solve in a compact vector.
+func TestDtrsvMatrixColumn(t *testing.T) {
+	const (
+		tol = 1e-13
+		ldb = 7
+	)
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 4, 5, 8, 13} {
+					lda := n + 3
+					a := randomTriangular(rnd, ul, n, lda)
+					for _, incX := range []int{ldb, -ldb} {
+						for col := 0; col < ldb; col++ {
+							prefix := fmt.Sprintf("ul=%v,tA=%v,d=%v,n=%v,incX=%v,col=%v", ul, tA, d, n, incX, col)
+
+							b := make([]float64, n*ldb)
+							for i := range b {
+								b[i] = rnd.NormFloat64()
+							}
+
+							// Gather column col in the order in which it is
+							// traversed by a vector with increment incX.
+							x := make([]float64, n)
+							for i := range x {
+								x[i] = b[vecIndex(i, n, incX)+col]
+							}
+							impl.Dtrsv(ul, tA, d, n, a, lda, x, 1)
+
+							got := make([]float64, len(b))
+							copy(got, b)
+							// The column slice ends at the last element of the
+							// column so that any access past it panics.
+							impl.Dtrsv(ul, tA, d, n, a, lda, got[col:col+(n-1)*ldb+1], incX)
+
+							for i := 0; i < n; i++ {
+								for j := 0; j < ldb; j++ {
+									if j == col {
+										continue
+									}
+									if got[i*ldb+j] != b[i*ldb+j] {
+										t.Errorf("%v: element (%v,%v) outside the column modified", prefix, i, j)
+									}
+								}
+							}
+							for i, want := range x {
+								v := got[vecIndex(i, n, incX)+col]
+								if !floats.EqualWithinAbsOrRel(v, want, tol, tol) {
+									t.Errorf("%v: unexpected x[%v]: got %v, want %v", prefix, i, v, want)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
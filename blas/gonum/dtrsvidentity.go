@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DtrsvIdentity solves one of the systems of equations
+//  A * x = b   if tA == blas.NoTrans
+//  Aᵀ * x = b  if tA == blas.Trans or blas.ConjTrans
+// where A is an n×n triangular matrix, and x and b are vectors, allowing the
+// caller to assert that A is the identity matrix.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// If identity is true and d == blas.Unit, the caller asserts that the
+// off-diagonal elements of the triangle of A are zero, so the solution is b.
+// DtrsvIdentity then returns after checking its arguments, without
+// referencing the elements of A. The assertion is not checked. Otherwise the
+// system is solved by Dtrsv. The assertion applies to this call only, so a
+// unit triangular solve elsewhere, such as within LAPACK routines using the
+// same Implementation, is not affected.
+func (impl Implementation) DtrsvIdentity(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int, identity bool) {
+	if !identity || d != blas.Unit {
+		impl.Dtrsv(ul, tA, d, n, a, lda, x, incX)
+		return
+	}
+
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+
+	// The solution of a system with the identity matrix is b, which is
+	// already held in x.
+}
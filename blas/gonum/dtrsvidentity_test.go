@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtrsvIdentity(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, n := range []int{0, 1, 2, 5} {
+				for _, incX := range []int{1, -2} {
+					lda := max(1, n+1)
+					name := fmt.Sprintf("ul=%c,tA=%c,n=%d,incX=%d", ul, tA, n, incX)
+
+					// For an actual identity the asserted path agrees
+					// with the general solve.
+					a := nanSlice(lda * n)
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							if i != j {
+								a[i*lda+j] = 0
+							}
+						}
+					}
+					x := randvec(n, incX, rnd)
+					want := make([]float64, len(x))
+					copy(want, x)
+					impl.Dtrsv(ul, tA, blas.Unit, n, a, lda, want, incX)
+					got := make([]float64, len(x))
+					copy(got, x)
+					impl.DtrsvIdentity(ul, tA, blas.Unit, n, a, lda, got, incX, true)
+					if !floats.Same(got, want) {
+						t.Errorf("%s: identity result differs from Dtrsv", name)
+					}
+
+					// The assertion is trusted, so the elements of A
+					// are not referenced.
+					copy(got, x)
+					impl.DtrsvIdentity(ul, tA, blas.Unit, n, nanSlice(len(a)), lda, got, incX, true)
+					if !floats.Same(got, x) {
+						t.Errorf("%s: x modified with asserted identity", name)
+					}
+
+					// Without the assertion, and for a non-unit diagonal,
+					// the system is solved by Dtrsv.
+					for _, d := range []blas.Diag{blas.Unit, blas.NonUnit} {
+						for _, identity := range []bool{false, true} {
+							if d == blas.Unit && identity {
+								continue
+							}
+							a := randomTriangular(rnd, ul, n, lda)
+							copy(want, x)
+							impl.Dtrsv(ul, tA, d, n, a, lda, want, incX)
+							copy(got, x)
+							impl.DtrsvIdentity(ul, tA, d, n, a, lda, got, incX, identity)
+							if !floats.Same(got, want) {
+								t.Errorf("%s,d=%c,identity=%t: result differs from Dtrsv", name, d, identity)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Argument checks are still performed with the assertion.
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{
+			name: "short a",
+			fn: func() {
+				impl.DtrsvIdentity(blas.Upper, blas.NoTrans, blas.Unit, 2, make([]float64, 3), 2, make([]float64, 2), 1, true)
+			},
+			want: shortA,
+		},
+		{
+			name: "short x",
+			fn: func() {
+				impl.DtrsvIdentity(blas.Upper, blas.NoTrans, blas.Unit, 2, make([]float64, 4), 2, make([]float64, 1), 1, true)
+			},
+			want: shortX,
+		},
+		{
+			name: "bad uplo",
+			fn: func() {
+				impl.DtrsvIdentity(blas.All, blas.NoTrans, blas.Unit, 2, make([]float64, 4), 2, make([]float64, 2), 1, true)
+			},
+			want: badUplo,
+		},
+	} {
+		if got := panicValue(test.fn); got != test.want {
+			t.Errorf("%s: unexpected panic: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
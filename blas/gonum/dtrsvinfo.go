@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DtrsvInfo solves one of the systems of equations
+//  A * x = b   if tA == blas.NoTrans
+//  Aᵀ * x = b  if tA == blas.Trans or blas.ConjTrans
+// where A is an n×n triangular matrix, and x and b are vectors, and reports
+// whether A is exactly singular.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// The solve is performed by Dtrsv and is not aborted for a singular A, in
+// which case x will hold infinite or NaN elements. After the solve, in the
+// manner of the LAPACK info parameter, DtrsvInfo returns the one-based index
+// i of the first diagonal element A[i-1][i-1] that is exactly zero, or zero
+// if there is none. DtrsvInfo always returns zero if d == blas.Unit.
+func (impl Implementation) DtrsvInfo(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) (info int) {
+	impl.Dtrsv(ul, tA, d, n, a, lda, x, incX)
+
+	if d == blas.Unit {
+		return 0
+	}
+	for i := 0; i < n; i++ {
+		if a[i*lda+i] == 0 {
+			return i + 1
+		}
+	}
+	return 0
+}
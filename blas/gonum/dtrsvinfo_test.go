@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtrsvInfo(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, n := range []int{0, 1, 2, 5, 8} {
+				for _, incX := range []int{1, -2} {
+					// zeros holds the diagonal indices set to zero.
+					zeroSets := [][]int{nil}
+					if n > 0 {
+						zeroSets = append(zeroSets, []int{0}, []int{n - 1}, []int{n / 2, n - 1})
+					}
+					for _, zeros := range zeroSets {
+						for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+							lda := n + 1
+							a := randvec(n*lda, 1, rnd)
+							for i := 0; i < n; i++ {
+								a[i*lda+i] = 2 + rnd.Float64()
+							}
+							for _, i := range zeros {
+								a[i*lda+i] = 0
+							}
+							x := randvec(n, incX, rnd)
+
+							want := make([]float64, len(x))
+							copy(want, x)
+							impl.Dtrsv(ul, tA, d, n, a, lda, want, incX)
+
+							got := make([]float64, len(x))
+							copy(got, x)
+							info := impl.DtrsvInfo(ul, tA, d, n, a, lda, got, incX)
+
+							name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%v,incX=%v,zeros=%v", ul, tA, d, n, incX, zeros)
+							wantInfo := 0
+							if d == blas.NonUnit && len(zeros) > 0 {
+								wantInfo = zeros[0] + 1
+							}
+							if info != wantInfo {
+								t.Errorf("%v: unexpected info: got %v, want %v", name, info, wantInfo)
+							}
+							if !floats.Same(got, want) {
+								t.Errorf("%v: result differs from Dtrsv:\ngot  %v\nwant %v", name, got, want)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DtrsvPermuted solves one of the systems of equations
+//  P * A * x = b       if tA == blas.NoTrans
+//  (P * A)ᵀ * x = b    if tA == blas.Trans or blas.ConjTrans
+// where A is an n×n triangular matrix, P is a permutation matrix, and x and b
+// are vectors.
+//
+// P is described by the row interchanges in ipiv as returned by LU
+// factorization routines such as Dgetrf, so that P = P_0 * P_1 * ... * P_{n-1}
+// where P_i interchanges rows i and ipiv[i]. The elements of ipiv are
+// zero-based. If tA == blas.NoTrans, the interchanges are applied to b in
+// increasing order before the triangular solve; otherwise they are applied to
+// the solution in decreasing order after it.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (impl Implementation) DtrsvPermuted(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int, ipiv []int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ipiv) < n {
+		panic(shortIPiv)
+	}
+	for _, p := range ipiv[:n] {
+		if p < 0 || n <= p {
+			panic(badIPiv)
+		}
+	}
+	// x may be permuted before Dtrsv is called, so check the remaining
+	// lengths here.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - n) * incX
+	}
+	if tA == blas.NoTrans {
+		for i, p := range ipiv[:n] {
+			if p != i {
+				x[kx+i*incX], x[kx+p*incX] = x[kx+p*incX], x[kx+i*incX]
+			}
+		}
+		impl.Dtrsv(ul, tA, d, n, a, lda, x, incX)
+		return
+	}
+	impl.Dtrsv(ul, tA, d, n, a, lda, x, incX)
+	for i := n - 1; i >= 0; i-- {
+		if p := ipiv[i]; p != i {
+			x[kx+i*incX], x[kx+p*incX] = x[kx+p*incX], x[kx+i*incX]
+		}
+	}
+}
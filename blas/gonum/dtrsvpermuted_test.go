@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtrsvPermuted(t *testing.T) {
+	const tol = 1e-12
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 5, 10} {
+					for _, incX := range []int{1, 2, -3} {
+						lda := n + 2
+						a := make([]float64, n*lda)
+						for i := 0; i < n; i++ {
+							for j := 0; j < n; j++ {
+								if (ul == blas.Upper && j > i) || (ul == blas.Lower && j < i) {
+									a[i*lda+j] = rnd.NormFloat64()
+								}
+							}
+							a[i*lda+i] = 2 + rnd.Float64()
+						}
+						ipiv := make([]int, n)
+						for i := range ipiv {
+							ipiv[i] = i + rnd.Intn(n-i)
+						}
+						b := randvec(n, incX, rnd)
+
+						prefix := fmt.Sprintf("ul=%v,tA=%v,d=%v,n=%v,incX=%v,ipiv=%v", ul, tA, d, n, incX, ipiv)
+
+						got := make([]float64, len(b))
+						copy(got, b)
+						impl.DtrsvPermuted(ul, tA, d, n, a, lda, got, incX, ipiv)
+
+						// Permute and solve manually.
+						want := make([]float64, len(b))
+						copy(want, b)
+						swap := func(i int) {
+							i0, i1 := vecIndex(i, n, incX), vecIndex(ipiv[i], n, incX)
+							want[i0], want[i1] = want[i1], want[i0]
+						}
+						if tA == blas.NoTrans {
+							for i := 0; i < n; i++ {
+								swap(i)
+							}
+							impl.Dtrsv(ul, tA, d, n, a, lda, want, incX)
+						} else {
+							impl.Dtrsv(ul, tA, d, n, a, lda, want, incX)
+							for i := n - 1; i >= 0; i-- {
+								swap(i)
+							}
+						}
+						if !floats.Same(got, want) {
+							t.Errorf("%v: result differs from permute-then-solve\nwant %v\ngot  %v", prefix, want, got)
+						}
+
+						// Check that x solves op(P*A)*x = b by forming
+						// P*A explicitly.
+						pa := make([]float64, n*n)
+						for i := 0; i < n; i++ {
+							for j := 0; j < n; j++ {
+								switch {
+								case i == j && d == blas.Unit:
+									pa[i*n+j] = 1
+								case (ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i):
+									pa[i*n+j] = a[i*lda+j]
+								}
+							}
+						}
+						for i := n - 1; i >= 0; i-- {
+							if p := ipiv[i]; p != i {
+								impl.Dswap(n, pa[i*n:], 1, pa[p*n:], 1)
+							}
+						}
+						r := make([]float64, len(b))
+						copy(r, b)
+						impl.Dgemv(tA, n, n, 1, pa, n, got, incX, -1, r, incX)
+						for i := 0; i < n; i++ {
+							if v := r[vecIndex(i, n, incX)]; math.Abs(v) > tol {
+								t.Errorf("%v: large residual at %v: %v", prefix, i, v)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
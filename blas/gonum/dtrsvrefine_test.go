@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDtrsvRefine(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 10, 50} {
+					for _, incX := range []int{1, 3, -2} {
+						testDtrsvRefine(t, rnd, ul, tA, d, n, incX)
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDtrsvRefine(t *testing.T, rnd *rand.Rand, ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, incX int) {
+	// Construct a moderately ill-conditioned triangular matrix with
+	// off-diagonal elements that are large relative to a widely varying
+	// diagonal.
+	lda := n + 2
+	a := make([]float64, n*lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (ul == blas.Upper && j > i) || (ul == blas.Lower && j < i) {
+				a[i*lda+j] = 2*rnd.Float64() - 1
+			}
+		}
+		a[i*lda+i] = math.Pow(10, -rnd.Float64())
+	}
+	b := randvec(n, incX, rnd)
+
+	prefix := fmt.Sprintf("ul=%v,tA=%v,d=%v,n=%v,incX=%v", ul, tA, d, n, incX)
+
+	x0 := make([]float64, len(b))
+	impl.DtrsvRefine(ul, tA, d, n, a, lda, b, x0, incX, 0)
+	xTrsv := make([]float64, len(b))
+	copy(xTrsv, b)
+	impl.Dtrsv(ul, tA, d, n, a, lda, xTrsv, incX)
+	for i := 0; i < n; i++ {
+		if j := vecIndex(i, n, incX); x0[j] != xTrsv[j] {
+			t.Errorf("%v: unrefined solution differs from Dtrsv at %v", prefix, i)
+			break
+		}
+	}
+
+	x := make([]float64, len(b))
+	bCopy := make([]float64, len(b))
+	copy(bCopy, b)
+	impl.DtrsvRefine(ul, tA, d, n, a, lda, b, x, incX, 3)
+	for i := range b {
+		if b[i] != bCopy[i] {
+			t.Errorf("%v: b modified", prefix)
+			break
+		}
+	}
+
+	// The refined solution is accurate to about the unit roundoff, so its
+	// residual is at the level of the rounding error in |op(A)|*|x|.
+	res, scale := trsvResidual(ul, tA, d, n, a, lda, b, x, incX)
+	tol := 2 * 0x1p-53 * scale
+	if res > tol {
+		t.Errorf("%v: residual after refinement %v exceeds %v", prefix, res, tol)
+	}
+}
+
+func TestDtrsvRefineIllConditioned(t *testing.T) {
+	const (
+		n = 50
+
+		// The unrefined solution must be in error by at least minErr0
+		// for the test to be meaningful, and the refined solution must be
+		// accurate to within tol, both relative to the exact solution.
+		minErr0 = 1e-12
+		tol     = 2 * 0x1p-53
+
+		// minReduction is the factor by which refinement must reduce the
+		// residual.
+		minReduction = 4
+	)
+	rnd := rand.New(rand.NewSource(1))
+
+	// kahan is the upper triangular Kahan matrix, which is ill-conditioned
+	// while having no small pivots.
+	s, c := math.Sin(1.2), math.Cos(1.2)
+	kahan := func(i, j int) float64 {
+		switch {
+		case j < i:
+			return 0
+		case j == i:
+			return math.Pow(s, float64(i))
+		}
+		return -c * math.Pow(s, float64(i))
+	}
+
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, incX := range []int{1, -2} {
+				prefix := fmt.Sprintf("ul=%v,tA=%v,incX=%v", ul, tA, incX)
+
+				lda := n
+				a := make([]float64, n*lda)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if ul == blas.Upper {
+							a[i*lda+j] = kahan(i, j)
+						} else {
+							a[i*lda+j] = kahan(j, i)
+						}
+					}
+				}
+
+				// Form b = op(A) * xTrue for an xTrue without large or small
+				// elements, so that the elements of b result from
+				// cancellation.
+				b := make([]float64, 1+(n-1)*abs(incX))
+				for i := 0; i < n; i++ {
+					b[vecIndex(i, n, incX)] = 1 + rnd.Float64()
+				}
+				impl.Dtrmv(ul, tA, blas.NonUnit, n, a, lda, b, incX)
+				want := exactTrsv(ul, tA, n, a, lda, b, incX)
+
+				x0 := make([]float64, len(b))
+				impl.DtrsvRefine(ul, tA, blas.NonUnit, n, a, lda, b, x0, incX, 0)
+				x := make([]float64, len(b))
+				impl.DtrsvRefine(ul, tA, blas.NonUnit, n, a, lda, b, x, incX, 3)
+
+				err0 := relErr(want, x0, n, incX)
+				if err0 < minErr0 {
+					t.Fatalf("%v: unrefined error %v too small for the test", prefix, err0)
+				}
+				if err := relErr(want, x, n, incX); err > tol {
+					t.Errorf("%v: error not reduced by refinement: before %v, after %v", prefix, err0, err)
+				}
+				res0, _ := trsvResidual(ul, tA, blas.NonUnit, n, a, lda, b, x0, incX)
+				res, _ := trsvResidual(ul, tA, blas.NonUnit, n, a, lda, b, x, incX)
+				if res > res0/minReduction {
+					t.Errorf("%v: residual not reduced by refinement: before %v, after %v", prefix, res0, res)
+				}
+			}
+		}
+	}
+}
+
+// exactTrsv returns the solution of op(A) * x = b, where A is an n×n
+// non-unit triangular matrix, computed with enough precision to be exact
+// after rounding to float64.
+func exactTrsv(ul blas.Uplo, tA blas.Transpose, n int, a []float64, lda int, b []float64, incX int) []float64 {
+	const prec = 4096
+	at := func(i, j int) *big.Float {
+		if tA != blas.NoTrans {
+			i, j = j, i
+		}
+		return new(big.Float).SetPrec(prec).SetFloat64(a[i*lda+j])
+	}
+	upper := (ul == blas.Upper) == (tA == blas.NoTrans)
+	x := make([]*big.Float, n)
+	for k := 0; k < n; k++ {
+		i := k
+		if upper {
+			i = n - 1 - k
+		}
+		sum := new(big.Float).SetPrec(prec).SetFloat64(b[vecIndex(i, n, incX)])
+		for j := 0; j < n; j++ {
+			if x[j] == nil || j == i {
+				continue
+			}
+			sum.Sub(sum, new(big.Float).SetPrec(prec).Mul(at(i, j), x[j]))
+		}
+		x[i] = sum.Quo(sum, at(i, i))
+	}
+	want := make([]float64, len(b))
+	for i := range x {
+		want[vecIndex(i, n, incX)], _ = x[i].Float64()
+	}
+	return want
+}
+
+// relErr returns the largest relative error of the n elements of x with
+// respect to want.
+func relErr(want, x []float64, n, incX int) float64 {
+	var e float64
+	for i := 0; i < n; i++ {
+		j := vecIndex(i, n, incX)
+		e = math.Max(e, math.Abs(x[j]-want[j])/math.Abs(want[j]))
+	}
+	return e
+}
+
+// trsvResidual returns the max norm of b - op(A)*x computed with compensated
+// dot products so that its own rounding error does not dominate, and the
+// max norm of |b| + |op(A)|*|x|.
+func trsvResidual(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, b, x []float64, incX int) (res, scale float64) {
+	at := func(i, j int) float64 {
+		if tA != blas.NoTrans {
+			i, j = j, i
+		}
+		switch {
+		case i == j && d == blas.Unit:
+			return 1
+		case ul == blas.Upper && j < i, ul == blas.Lower && j > i:
+			return 0
+		}
+		return a[i*lda+j]
+	}
+	for i := 0; i < n; i++ {
+		// Accumulate b[i] - \sum_j A[i][j]*x[j] using error-free
+		// transformations for the products and sums.
+		sum := b[vecIndex(i, n, incX)]
+		abs := math.Abs(sum)
+		var comp float64
+		for j := 0; j < n; j++ {
+			p := -at(i, j) * x[vecIndex(j, n, incX)]
+			pe := math.FMA(-at(i, j), x[vecIndex(j, n, incX)], -p)
+			s := sum + p
+			bb := s - sum
+			se := (sum - (s - bb)) + (p - bb)
+			sum = s
+			comp += se + pe
+			abs += math.Abs(p)
+		}
+		res = math.Max(res, math.Abs(sum+comp))
+		scale = math.Max(scale, abs)
+	}
+	return res, scale
+}
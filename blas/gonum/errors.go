@@ -9,6 +9,9 @@ package gonum
 const (
 	zeroIncX = "blas: zero x index increment"
 	zeroIncY = "blas: zero y index increment"
+	zeroIncD = "blas: zero d index increment"
+	zeroIncB = "blas: zero b index increment"
+	zeroIncR = "blas: zero r index increment"
 
 	mLT0  = "blas: m < 0"
 	nLT0  = "blas: n < 0"
@@ -16,20 +19,75 @@ const (
 	kLLT0 = "blas: kL < 0"
 	kULT0 = "blas: kU < 0"
 
+	itersLT0 = "blas: iters < 0"
+	pLT0     = "blas: p < 0"
+	nrhsLT0  = "blas: nrhs < 0"
+	termsLT0 = "blas: terms < 0"
+	rankLT0  = "blas: rank < 0"
+	countLT0 = "blas: count < 0"
+
 	badUplo      = "blas: illegal triangle"
 	badTranspose = "blas: illegal transpose"
 	badDiag      = "blas: illegal diagonal"
 	badSide      = "blas: illegal side"
 	badFlag      = "blas: illegal rotm flag"
 
+	badBandFormat = "blas: illegal band format"
+	badCSR        = "blas: malformed CSR matrix"
+	badHalfFormat = "blas: illegal half-precision format"
+	badClampRange = "blas: illegal clamp range"
+
+	zeroDiag = "blas: zero diagonal element"
+
 	badLdA = "blas: bad leading dimension of A"
 	badLdB = "blas: bad leading dimension of B"
 	badLdC = "blas: bad leading dimension of C"
 
+	badLdAcc = "blas: bad leading dimension of acc"
+	badLdX   = "blas: bad leading dimension of x"
+	badLdY   = "blas: bad leading dimension of y"
+	badLdG   = "blas: bad leading dimension of g"
+	badLdU   = "blas: bad leading dimension of u"
+
+	rowIndexLT0 = "blas: rowIndex < 0"
+	r0LT0       = "blas: r0 < 0"
+	c0LT0       = "blas: c0 < 0"
+
+	badRowIndex = "blas: row index out of range"
+	badRowLen   = "blas: rows of a have different lengths"
+
+	batchStrideLT0 = "blas: batchStride < 0"
+	badXStride     = "blas: bad stride of x"
+
 	shortX  = "blas: insufficient length of x"
 	shortY  = "blas: insufficient length of y"
 	shortAP = "blas: insufficient length of ap"
 	shortA  = "blas: insufficient length of a"
 	shortB  = "blas: insufficient length of b"
 	shortC  = "blas: insufficient length of c"
+
+	shortAcc  = "blas: insufficient length of acc"
+	shortW    = "blas: insufficient length of w"
+	shortD    = "blas: insufficient length of d"
+	shortG    = "blas: insufficient length of g"
+	shortOut  = "blas: insufficient length of out"
+	shortR    = "blas: insufficient length of r"
+	shortU    = "blas: insufficient length of u"
+	shortBias = "blas: insufficient length of bias"
+
+	shortAlphas = "blas: insufficient length of alphas"
+	shortBetas  = "blas: insufficient length of betas"
+
+	shortRowMask = "blas: insufficient length of rowMask"
+	shortColMask = "blas: insufficient length of colMask"
+
+	shortRowScale = "blas: insufficient length of rowScale"
+
+	shortIPiv = "blas: insufficient length of ipiv"
+	badIPiv   = "blas: ipiv element out of range"
+
+	shortYIdx = "blas: insufficient length of yIdx"
+	badYIdx   = "blas: yIdx element out of range"
+
+	badLenAP = "blas: length of ap is not the packed size"
 )
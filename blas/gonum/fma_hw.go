@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build arm64 ppc64 ppc64le riscv64 s390x
+
+package gonum
+
+// useFMA indicates that math.FMA is always implemented by a single
+// hardware instruction on the target architecture, so fused kernels
+// are both faster and more accurate than the unfused kernels.
+// The float32 fused kernels used by Saxpy do not call math.FMA; they
+// round each update once to float32 using float64 arithmetic, which is
+// more accurate but not faster than the unfused kernels.
+const useFMA = true
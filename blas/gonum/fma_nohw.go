@@ -0,0 +1,14 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !arm64,!ppc64,!ppc64le,!riscv64,!s390x
+
+package gonum
+
+// useFMA indicates that math.FMA is always implemented by a single
+// hardware instruction on the target architecture. On the remaining
+// architectures hardware FMA support is either absent or only detectable
+// at run time and math.FMA may fall back to a slow software implementation,
+// so the unfused, assembly-accelerated kernels are used.
+const useFMA = false
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+func TestDaxpyFMA(t *testing.T) {
+	// The fused kernels are pure Go, so they are tested on every
+	// architecture through daxpyFused, and through Daxpy where it uses them.
+	type daxpyer struct {
+		name string
+		fn   func(n int, alpha float64, x []float64, incX int, y []float64, incY int)
+	}
+	daxpys := []daxpyer{{name: "fused kernels", fn: daxpyFused}}
+	if useFMA {
+		daxpys = append(daxpys, daxpyer{name: "Daxpy", fn: impl.Daxpy})
+	}
+
+	// alpha*x = 1 - 2⁻⁶⁰ exactly, which is only representable as the
+	// result of the update when the product is not rounded.
+	alpha := 1 + math.Ldexp(1, -30)
+	xv := 1 - math.Ldexp(1, -30)
+	const want = -0x1p-60
+	for _, daxpy := range daxpys {
+		for _, test := range []struct {
+			n, incX, incY int
+		}{
+			{n: 3, incX: 1, incY: 1},
+			{n: 2, incX: 2, incY: 2},
+			{n: 2, incX: 2, incY: -2},
+		} {
+			x := []float64{xv, xv, xv}
+			y := []float64{-1, -1, -1}
+			daxpy.fn(test.n, alpha, x, test.incX, y, test.incY)
+			for i := 0; i < test.n; i++ {
+				if v := y[i*abs(test.incY)]; v != want {
+					t.Errorf("%v: n=%v,incX=%v,incY=%v: unexpected result at %v: got %v, want %v",
+						daxpy.name, test.n, test.incX, test.incY, i, v, want)
+				}
+			}
+		}
+	}
+}
+
+// daxpyFused computes y += alpha * x with the fused kernels that Daxpy uses
+// when useFMA is true.
+func daxpyFused(n int, alpha float64, x []float64, incX int, y []float64, incY int) {
+	if incX == 1 && incY == 1 {
+		f64.AxpyUnitaryFMA(alpha, x[:n], y[:n])
+		return
+	}
+	var ix, iy int
+	if incX < 0 {
+		ix = (-n + 1) * incX
+	}
+	if incY < 0 {
+		iy = (-n + 1) * incY
+	}
+	f64.AxpyIncFMA(alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
+}
@@ -0,0 +1,161 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// dgbmvBand computes
+//  y = alpha * A * x + y   if tA == blas.NoTrans
+//  y = alpha * Aᵀ * x + y  if tA == blas.Trans or blas.ConjTrans
+// where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals.
+// kx and ky are the indices of the first elements of x and y. The arguments
+// are assumed to have been checked by Dgbmv.
+func dgbmvBand(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX, kx int, y []float64, incY, ky int) {
+	// i and j are indices of the compacted banded matrix.
+	// off is the offset into the dense matrix (off + j = densej)
+	nCol := kU + 1 + kL
+	if tA == blas.NoTrans {
+		iy := ky
+		if incX == 1 {
+			for i := 0; i < min(m, n+kL); i++ {
+				l := max(0, kL-i)
+				u := min(nCol, n+kL-i)
+				off := max(0, i-kL)
+				atmp := a[i*lda+l : i*lda+u]
+				xtmp := x[off : off+u-l]
+				var sum float64
+				for j, v := range atmp {
+					sum += xtmp[j] * v
+				}
+				y[iy] += sum * alpha
+				iy += incY
+			}
+			return
+		}
+		for i := 0; i < min(m, n+kL); i++ {
+			l := max(0, kL-i)
+			u := min(nCol, n+kL-i)
+			off := max(0, i-kL)
+			atmp := a[i*lda+l : i*lda+u]
+			jx := kx
+			var sum float64
+			for _, v := range atmp {
+				sum += x[off*incX+jx] * v
+				jx += incX
+			}
+			y[iy] += sum * alpha
+			iy += incY
+		}
+		return
+	}
+	if incX == 1 {
+		for i := 0; i < min(m, n+kL); i++ {
+			l := max(0, kL-i)
+			u := min(nCol, n+kL-i)
+			off := max(0, i-kL)
+			atmp := a[i*lda+l : i*lda+u]
+			tmp := alpha * x[i]
+			jy := ky
+			for _, v := range atmp {
+				y[jy+off*incY] += tmp * v
+				jy += incY
+			}
+		}
+		return
+	}
+	ix := kx
+	for i := 0; i < min(m, n+kL); i++ {
+		l := max(0, kL-i)
+		u := min(nCol, n+kL-i)
+		off := max(0, i-kL)
+		atmp := a[i*lda+l : i*lda+u]
+		tmp := alpha * x[ix]
+		jy := ky
+		for _, v := range atmp {
+			y[jy+off*incY] += tmp * v
+			jy += incY
+		}
+		ix += incX
+	}
+}
+
+// dgbmvTridiag computes
+//  y = alpha * A * x + y   if tA == blas.NoTrans
+//  y = alpha * Aᵀ * x + y  if tA == blas.Trans or blas.ConjTrans
+// where A is an m×n tridiagonal matrix stored in band format with kL = kU = 1.
+// kx and ky are the indices of the first elements of x and y. The arguments
+// are assumed to have been checked by Dgbmv.
+//
+// The products and sums are formed in the same order as in dgbmvBand, so
+// the result is identical, but the rows with all three diagonals inside the
+// matrix are computed without slicing or inner loops.
+func dgbmvTridiag(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX, kx int, y []float64, incY, ky int) {
+	// Row i of the band holds A[i][i-1], A[i][i] and A[i][i+1]. Rows
+	// 1 ≤ i < mid have all three elements inside the matrix, the others
+	// are handled by edge.
+	r := min(m, n+1)
+	mid := max(1, min(r, n-1))
+	if tA == blas.NoTrans {
+		edge := func(i int) {
+			row := a[i*lda : i*lda+3]
+			var sum float64
+			if i > 0 {
+				sum += x[kx+(i-1)*incX] * row[0]
+			}
+			if i < n {
+				sum += x[kx+i*incX] * row[1]
+			}
+			if i+1 < n {
+				sum += x[kx+(i+1)*incX] * row[2]
+			}
+			y[ky+i*incY] += sum * alpha
+		}
+		edge(0)
+		jx := kx
+		iy := ky + incY
+		for i := 1; i < mid; i++ {
+			row := a[i*lda : i*lda+3]
+			var sum float64
+			sum += x[jx] * row[0]
+			sum += x[jx+incX] * row[1]
+			sum += x[jx+2*incX] * row[2]
+			y[iy] += sum * alpha
+			jx += incX
+			iy += incY
+		}
+		for i := mid; i < r; i++ {
+			edge(i)
+		}
+		return
+	}
+	edge := func(i int) {
+		row := a[i*lda : i*lda+3]
+		tmp := alpha * x[kx+i*incX]
+		if i > 0 {
+			y[ky+(i-1)*incY] += tmp * row[0]
+		}
+		if i < n {
+			y[ky+i*incY] += tmp * row[1]
+		}
+		if i+1 < n {
+			y[ky+(i+1)*incY] += tmp * row[2]
+		}
+	}
+	edge(0)
+	ix := kx + incX
+	jy := ky
+	for i := 1; i < mid; i++ {
+		row := a[i*lda : i*lda+3]
+		tmp := alpha * x[ix]
+		y[jy] += tmp * row[0]
+		y[jy+incY] += tmp * row[1]
+		y[jy+2*incY] += tmp * row[2]
+		ix += incX
+		jy += incY
+	}
+	for i := mid; i < r; i++ {
+		edge(i)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgbmvTridiag(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {1, 2}, {2, 1}, {1, 5}, {5, 1}, {2, 2}, {3, 3}, {4, 6}, {6, 4}, {7, 7}, {9, 8}} {
+			m, n := mn[0], mn[1]
+			for _, lda := range []int{3, 5} {
+				for _, inc := range [][2]int{{1, 1}, {2, 3}, {-1, 1}, {3, -2}} {
+					incX, incY := inc[0], inc[1]
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					a := randvec(lda*min(m, n+1), 1, rnd)
+					x := randvec(lenX, incX, rnd)
+					y := randvec(lenY, incY, rnd)
+					for _, alpha := range []float64{0, 1, -2.5} {
+						for _, beta := range []float64{0, 1, 0.5} {
+							want := make([]float64, len(y))
+							copy(want, y)
+							var kx, ky int
+							if incX < 0 {
+								kx = -(lenX - 1) * incX
+							}
+							if incY < 0 {
+								ky = -(lenY - 1) * incY
+							}
+							if beta != 1 {
+								impl.Dscal(lenY, beta, want, abs(incY))
+							}
+							if alpha != 0 {
+								dgbmvBand(tA, m, n, 1, 1, alpha, a, lda, x, incX, kx, want, incY, ky)
+							}
+
+							got := make([]float64, len(y))
+							copy(got, y)
+							impl.Dgbmv(tA, m, n, 1, 1, alpha, a, lda, x, incX, beta, got, incY)
+
+							for i := range want {
+								if got[i] != want[i] {
+									t.Errorf("tA=%v,m=%v,n=%v,lda=%v,incX=%v,incY=%v,alpha=%v,beta=%v: result differs from general band kernel at %v: want %v, got %v",
+										tA, m, n, lda, incX, incY, alpha, beta, i, want[i], got[i])
+									break
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDgbmvTridiag(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{10, 1000, 100000} {
+			a := randvec(3*n, 1, rnd)
+			x := randvec(n, 1, rnd)
+			y := randvec(n, 1, rnd)
+			b.Run(fmt.Sprintf("dgbmvBand/tA=%c/n=%d", tA, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					dgbmvBand(tA, n, n, 1, 1, 1, a, 3, x, 1, 0, y, 1, 0)
+				}
+			})
+			b.Run(fmt.Sprintf("dgbmvTridiag/tA=%c/n=%d", tA, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					dgbmvTridiag(tA, n, n, 1, a, 3, x, 1, 0, y, 1, 0)
+				}
+			})
+		}
+	}
+}
@@ -16,7 +16,28 @@ import (
 //  y = alpha * A * x + beta * y   if tA = blas.NoTrans
 //  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
-func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+//
+// x and y may be the same vector, that is, share their first element, in
+// which case x is copied to a temporary before y is updated. Only this case is
+// detected; x and y that overlap without sharing their first element, for
+// example slices of the same array with different offsets, give an undefined
+// result.
+//
+// If the CheckZeroX field of the receiver is true and x is the zero vector,
+// Dgemv only scales y by beta without referencing A. The scan of x costs
+// O(len(x)), which is small compared to the O(m*n) product, but is wasted when
+// x is known to be non-zero. Note that with the check enabled NaN and Inf
+// elements of A do not propagate into y when x is zero.
+//
+// If the Reproducible field of the receiver is true, Dgemv ignores the
+// gemvunroll tag and computes the result with the kernels described in the
+// Reproducible documentation.
+//
+// When built with the gemvunroll tag, the row dot products for tA = blas.NoTrans
+// and incX = 1 are accumulated in four partial sums if n is at least the
+// GemvUnroll threshold, so the result may differ in the last bits from the
+// default strict summation order.
+func (impl Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
 		panic(badTranspose)
 	}
@@ -48,6 +69,10 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), generalMaxIndex(m, n, lda), shortA)
+	}
+
 	if (incX > 0 && (lenX-1)*incX >= len(x)) || (incX < 0 && (1-lenX)*incX >= len(x)) {
 		panic(shortX)
 	}
@@ -63,18 +88,35 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 		return
 	}
 
-	if alpha == 0 {
+	if alpha == 0 || (impl.CheckZeroX && isZeroVec(lenX, x, incX)) {
 		// First form y = beta * y
-		if incY > 0 {
-			Implementation{}.Dscal(lenY, beta, y, incY)
-		} else {
-			Implementation{}.Dscal(lenY, beta, y, -incY)
-		}
+		dscalePrepY(lenY, beta, y, incY)
+		return
+	}
+
+	impl.Counter.add(m * n)
+
+	if &x[0] == &y[0] {
+		// x and y alias, so copy x to a temporary to keep it from
+		// being overwritten before it has been completely read. Overlap
+		// that does not start at the first element is not detected.
+		tmp := make([]float64, lenX)
+		impl.Dcopy(lenX, x, incX, tmp, 1)
+		x = tmp
+		incX = 1
+	}
+
+	if impl.Reproducible {
+		dgemvStrict(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
 		return
 	}
 
 	// Form y = alpha * A * x + y
 	if tA == blas.NoTrans {
+		if impl.useUnrolledGemv(n, incX) {
+			dgemvNUnroll4(m, n, alpha, a, lda, x, beta, y, incY)
+			return
+		}
 		f64.GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
 		return
 	}
@@ -82,13 +124,52 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 	f64.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
 }
 
+// isZeroVec returns whether all n elements of the vector x with increment
+// incX are zero.
+func isZeroVec(n int, x []float64, incX int) bool {
+	var ix int
+	if incX < 0 {
+		ix = (1 - n) * incX
+	}
+	for i := 0; i < n; i++ {
+		if x[ix] != 0 {
+			return false
+		}
+		ix += incX
+	}
+	return true
+}
+
+// dgemvNUnroll4 computes
+//  y = alpha * A * x + beta * y
+// where A is an m×n dense matrix, x is a vector with unit increment, y is a
+// vector, and alpha and beta are scalars. The dot product of each row of A
+// with x is accumulated in four partial sums to improve instruction-level
+// parallelism.
+func dgemvNUnroll4(m, n int, alpha float64, a []float64, lda int, x []float64, beta float64, y []float64, incY int) {
+	var iy int
+	if incY < 0 {
+		iy = (1 - m) * incY
+	}
+	x = x[:n]
+	for i := 0; i < m; i++ {
+		dot := f64.DotUnitaryUnroll4(a[i*lda:i*lda+n], x)
+		if beta == 0 {
+			y[iy] = alpha * dot
+		} else {
+			y[iy] = y[iy]*beta + alpha*dot
+		}
+		iy += incY
+	}
+}
+
 // Sgemv computes
 //  y = alpha * A * x + beta * y   if tA = blas.NoTrans
 //  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+func (impl Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
 	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
 		panic(badTranspose)
 	}
@@ -113,6 +194,10 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), generalMaxIndex(m, n, lda), shortA)
+	}
+
 	// Set up indexes
 	lenX := m
 	lenY := n
@@ -136,16 +221,14 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 	}
 
 	// First form y = beta * y
-	if incY > 0 {
-		Implementation{}.Sscal(lenY, beta, y, incY)
-	} else {
-		Implementation{}.Sscal(lenY, beta, y, -incY)
-	}
+	sscalePrepY(lenY, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
+	impl.Counter.add(m * n)
+
 	var kx, ky int
 	if incX < 0 {
 		kx = -(lenX - 1) * incX
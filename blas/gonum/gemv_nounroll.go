@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !gemvunroll
+
+package gonum
+
+// unrollGemv indicates that the row dot products of Dgemv are computed in
+// strict summation order by the default kernels.
+const unrollGemv = false
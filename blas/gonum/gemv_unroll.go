@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build gemvunroll
+
+package gonum
+
+// unrollGemv indicates that the row dot products of Dgemv with a
+// non-transposed matrix and unit increment x are accumulated in four
+// partial sums. The result may differ in the last bits from the result
+// computed in strict summation order.
+const unrollGemv = true
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemvAliased(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{1, 2, 5, 17} {
+			for _, inc := range [][2]int{{1, 1}, {2, 2}, {-3, -3}, {1, -1}, {-2, 3}} {
+				incX, incY := inc[0], inc[1]
+				for _, beta := range []float64{0, 1, -0.5} {
+					a := randmat(n, n, n, rnd)
+					data := randvec(n, max(abs(incX), abs(incY)), rnd)
+
+					x := make([]float64, len(data))
+					copy(x, data)
+					want := make([]float64, len(data))
+					copy(want, data)
+					impl.Dgemv(tA, n, n, 1.5, a, n, x, incX, beta, want, incY)
+
+					got := make([]float64, len(data))
+					copy(got, data)
+					impl.Dgemv(tA, n, n, 1.5, a, n, got, incX, beta, got, incY)
+
+					prefix := fmt.Sprintf("tA=%v,n=%v,incX=%v,incY=%v,beta=%v", tA, n, incX, incY, beta)
+					if !floats.EqualApprox(got, want, tol) {
+						t.Errorf("%v: unexpected result with x == y\nwant %v\ngot  %v", prefix, want, got)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// HalfFormat specifies the encoding of 16-bit floating-point values.
+type HalfFormat byte
+
+const (
+	// IEEEHalf is the IEEE 754 binary16 format with 5 exponent bits and
+	// 10 significand bits.
+	IEEEHalf HalfFormat = iota
+	// BFloat16 is the bfloat16 format, the upper 16 bits of an IEEE 754
+	// binary32 value, with 8 exponent bits and 7 significand bits.
+	BFloat16
+)
+
+// DgemvF16 performs one of the matrix-vector operations
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix of 16-bit values encoded with format, x and y
+// are float32 vectors, and alpha and beta are scalars. The requirements on the
+// arguments are the same as for Sgemv.
+//
+// Each element of A is decoded to float32 when it is used and the products
+// are accumulated in float32, so no decoded copy of A is made. The decoding is
+// exact, including for subnormal, infinite and NaN values.
+func (Implementation) DgemvF16(tA blas.Transpose, m, n int, alpha float32, a []uint16, format HalfFormat, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	var decode func(uint16) float32
+	switch format {
+	default:
+		panic(badHalfFormat)
+	case IEEEHalf:
+		decode = halfToFloat32
+	case BFloat16:
+		decode = bfloat16ToFloat32
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	// First form y = beta * y
+	sscalePrepY(lenY, beta, y, incY)
+
+	if alpha == 0 {
+		return
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = -(lenX - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(lenY - 1) * incY
+	}
+
+	// Form y = alpha * A * x + y
+	if tA == blas.NoTrans {
+		iy := ky
+		for i := 0; i < m; i++ {
+			var sum float32
+			jx := kx
+			for _, v := range a[i*lda : i*lda+n] {
+				sum += decode(v) * x[jx]
+				jx += incX
+			}
+			y[iy] += alpha * sum
+			iy += incY
+		}
+		return
+	}
+	// Cases where a is transposed.
+	ix := kx
+	for i := 0; i < m; i++ {
+		tmp := alpha * x[ix]
+		if tmp != 0 {
+			jy := ky
+			for _, v := range a[i*lda : i*lda+n] {
+				y[jy] += tmp * decode(v)
+				jy += incY
+			}
+		}
+		ix += incX
+	}
+}
+
+// halfToFloat32 returns the float32 value of the IEEE 754 binary16 value h.
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+	switch exp {
+	case 0:
+		// Zero and subnormal values are mant * 2^-24.
+		f := float32(mant) * 0x1p-24
+		if sign != 0 {
+			f = -f
+		}
+		return f
+	case 0x1f:
+		// Infinities and NaN, keeping the payload.
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	}
+	// Rebias the exponent from 15 to 127.
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// bfloat16ToFloat32 returns the float32 value of the bfloat16 value h.
+func bfloat16ToFloat32(h uint16) float32 {
+	return math.Float32frombits(uint32(h) << 16)
+}
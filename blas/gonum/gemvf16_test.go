@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestHalfToFloat32(t *testing.T) {
+	inf := float32(math.Inf(1))
+	for _, test := range []struct {
+		h    uint16
+		want float32
+	}{
+		{0x0000, 0},
+		{0x3c00, 1},
+		{0xc000, -2},
+		{0x3555, 0x1.554p-2},
+		{0x7bff, 65504},
+		{0x0400, 0x1p-14},
+		{0x03ff, 0x1.ff8p-15},
+		{0x0001, 0x1p-24},
+		{0x8001, -0x1p-24},
+		{0x7c00, inf},
+		{0xfc00, -inf},
+	} {
+		got := halfToFloat32(test.h)
+		if got != test.want {
+			t.Errorf("unexpected value for %#04x: got %v, want %v", test.h, got, test.want)
+		}
+	}
+	if got := halfToFloat32(0x8000); got != 0 || !math.Signbit(float64(got)) {
+		t.Errorf("unexpected value for 0x8000: got %v, want -0", got)
+	}
+	for _, h := range []uint16{0x7e00, 0x7c01, 0xfe00} {
+		if got := halfToFloat32(h); got == got {
+			t.Errorf("unexpected value for %#04x: got %v, want NaN", h, got)
+		}
+	}
+}
+
+func TestBfloat16ToFloat32(t *testing.T) {
+	inf := float32(math.Inf(1))
+	for _, test := range []struct {
+		h    uint16
+		want float32
+	}{
+		{0x0000, 0},
+		{0x3f80, 1},
+		{0xc000, -2},
+		{0x3eab, 0x1.56p-2},
+		{0x7f7f, 0x1.fep127},
+		{0x0001, 0x1p-133},
+		{0x7f80, inf},
+		{0xff80, -inf},
+	} {
+		got := bfloat16ToFloat32(test.h)
+		if got != test.want {
+			t.Errorf("unexpected value for %#04x: got %v, want %v", test.h, got, test.want)
+		}
+	}
+	if got := bfloat16ToFloat32(0x7fc0); got == got {
+		t.Errorf("unexpected value for 0x7fc0: got %v, want NaN", got)
+	}
+}
+
+func TestDgemvF16(t *testing.T) {
+	const tol = 1e-5
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, format := range []HalfFormat{IEEEHalf, BFloat16} {
+		decode := halfToFloat32
+		if format == BFloat16 {
+			decode = bfloat16ToFloat32
+		}
+		for _, dims := range [][2]int{{1, 1}, {1, 5}, {4, 1}, {3, 3}, {7, 12}, {20, 9}} {
+			m, n := dims[0], dims[1]
+			for _, lda := range []int{n, n + 3} {
+				a := make([]uint16, m*lda)
+				for i := range a {
+					a[i] = randHalf(rnd, format)
+				}
+				aDec := make([]float32, len(a))
+				for i, v := range a {
+					aDec[i] = decode(v)
+				}
+				for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {1, -2}} {
+						for _, ab := range [][2]float32{{0, 0.5}, {1, 0}, {-1.5, 2}, {0.5, 1}} {
+							alpha, beta := ab[0], ab[1]
+							name := fmt.Sprintf("format=%v,m=%v,n=%v,lda=%v,tA=%v,incX=%v,incY=%v,alpha=%v,beta=%v",
+								format, m, n, lda, tA, inc.x, inc.y, alpha, beta)
+							x := randvec32(lenX, inc.x, rnd)
+							y := randvec32(lenY, inc.y, rnd)
+
+							want := make([]float32, len(y))
+							copy(want, y)
+							impl.Sgemv(tA, m, n, alpha, aDec, lda, x, inc.x, beta, want, inc.y)
+
+							got := make([]float32, len(y))
+							copy(got, y)
+							impl.DgemvF16(tA, m, n, alpha, a, format, lda, x, inc.x, beta, got, inc.y)
+
+							// The summation order differs from Sgemv, so the
+							// error is bounded relative to the largest element.
+							scale := 1.0
+							for _, v := range want {
+								scale = math.Max(scale, math.Abs(float64(v)))
+							}
+							for i := range got {
+								if !floats.EqualWithinAbs(float64(got[i]), float64(want[i]), tol*scale) {
+									t.Errorf("%v: result differs from Sgemv\nwant %v\ngot  %v", name, want, got)
+									break
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// randHalf returns a random finite 16-bit value in the given format with
+// magnitude between about 2^-6 and 2^6, or a subnormal value for IEEEHalf.
+func randHalf(rnd *rand.Rand, format HalfFormat) uint16 {
+	sign := uint16(rnd.Intn(2)) << 15
+	if format == BFloat16 {
+		exp := uint16(127 - 6 + rnd.Intn(13))
+		return sign | exp<<7 | uint16(rnd.Intn(1<<7))
+	}
+	if rnd.Intn(10) == 0 {
+		return sign | uint16(rnd.Intn(1<<10))
+	}
+	exp := uint16(15 - 6 + rnd.Intn(13))
+	return sign | exp<<10 | uint16(rnd.Intn(1<<10))
+}
+
+// randvec32 returns a float32 vector of length n with increment inc filled
+// with random values.
+func randvec32(n, inc int, rnd *rand.Rand) []float32 {
+	if n == 0 {
+		return nil
+	}
+	if inc < 0 {
+		inc = -inc
+	}
+	x := make([]float32, (n-1)*inc+1)
+	for i := range x {
+		x[i] = float32(rnd.NormFloat64())
+	}
+	return x
+}
+
+func TestDgemvF16Panics(t *testing.T) {
+	a := make([]uint16, 4)
+	x := make([]float32, 2)
+	y := make([]float32, 2)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"bad transpose", func() { impl.DgemvF16('X', 2, 2, 1, a, IEEEHalf, 2, x, 1, 0, y, 1) }},
+		{"bad format", func() { impl.DgemvF16(blas.NoTrans, 2, 2, 1, a, 2, 2, x, 1, 0, y, 1) }},
+		{"bad lda", func() { impl.DgemvF16(blas.NoTrans, 2, 2, 1, a, IEEEHalf, 1, x, 1, 0, y, 1) }},
+		{"short a", func() { impl.DgemvF16(blas.NoTrans, 2, 2, 1, a[:3], BFloat16, 2, x, 1, 0, y, 1) }},
+		{"short x", func() { impl.DgemvF16(blas.NoTrans, 2, 2, 1, a, IEEEHalf, 2, x[:1], 1, 0, y, 1) }},
+		{"short y", func() { impl.DgemvF16(blas.Trans, 2, 2, 1, a, IEEEHalf, 2, x, 1, 0, y, 2) }},
+	} {
+		if !panics(test.f) {
+			t.Errorf("%v: expected panic", test.name)
+		}
+	}
+}
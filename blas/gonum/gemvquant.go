@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// quantBlock is the largest number of products of int8 values whose sum is
+// guaranteed to fit in an int32.
+const quantBlock = (1<<31 - 1) / (128 * 128)
+
+// DgemvQuant computes
+//  y = diag(rowScale) * A * (xScale * x)     if tA = blas.NoTrans
+//  y = (diag(rowScale) * A)ᵀ * (xScale * x)  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n matrix of int8 values with a float32 scale for each row,
+// x is an int8 vector with unit increment and scale xScale, and y is a
+// float32 vector. The input values of y are not used, and y is set to zero if
+// the product has no terms.
+//
+// For tA == blas.NoTrans the dot product of each row of A with x is
+// accumulated exactly in int32, in blocks of columns short enough that the
+// sum cannot overflow, and the scales are applied to the result. Otherwise
+// the row scales differ along each sum, so the exact products of the int8
+// values are scaled and accumulated in float32.
+func (Implementation) DgemvQuant(tA blas.Transpose, m, n int, a []int8, lda int, rowScale []float32, x []int8, xScale float32, y []float32, incY int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+
+	// Quick return if possible.
+	if lenY == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(rowScale) < m {
+		panic(shortRowScale)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if lenX > 0 {
+		if len(x) < lenX {
+			panic(shortX)
+		}
+		if len(a) < lda*(m-1)+n {
+			panic(shortA)
+		}
+	}
+
+	var ky int
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	if lenX == 0 {
+		// The product has no terms, so y is zero.
+		iy := ky
+		for i := 0; i < lenY; i++ {
+			y[iy] = 0
+			iy += incY
+		}
+		return
+	}
+
+	if tA == blas.NoTrans {
+		iy := ky
+		for i := 0; i < m; i++ {
+			row := a[i*lda : i*lda+n]
+			var dot int64
+			for j0 := 0; j0 < n; j0 += quantBlock {
+				j1 := min(n, j0+quantBlock)
+				var sum int32
+				for j, v := range row[j0:j1] {
+					sum += int32(v) * int32(x[j0+j])
+				}
+				dot += int64(sum)
+			}
+			y[iy] = rowScale[i] * xScale * float32(dot)
+			iy += incY
+		}
+		return
+	}
+
+	iy := ky
+	for j := 0; j < n; j++ {
+		y[iy] = 0
+		iy += incY
+	}
+	for i := 0; i < m; i++ {
+		s := rowScale[i] * xScale
+		xi := int32(x[i])
+		iy := ky
+		for _, v := range a[i*lda : i*lda+n] {
+			y[iy] += s * float32(int32(v)*xi)
+			iy += incY
+		}
+	}
+}
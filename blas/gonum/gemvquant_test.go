@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgemvQuant(t *testing.T) {
+	const tol = 1e-5 // Relative to the sum of the magnitudes of the terms.
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {3, 5}, {8, 2}, {30, 30}} {
+			for _, incY := range []int{1, -2} {
+				m, n := mn.m, mn.n
+				name := fmt.Sprintf("tA=%c,m=%d,n=%d,incY=%d", tA, m, n, incY)
+				lenX, lenY := n, m
+				if tA != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				lda := n + 1
+
+				// Quantize random data symmetrically to [-127, 127] with a
+				// scale for each row of A and one for x.
+				a0 := randomDense(rnd, m, n, lda)
+				x0 := randvec(lenX, 1, rnd)
+				a := make([]int8, len(a0))
+				rowScale := make([]float32, m)
+				for i := 0; i < m; i++ {
+					var aMax float64
+					for j := 0; j < n; j++ {
+						aMax = math.Max(aMax, math.Abs(a0[i*lda+j]))
+					}
+					rowScale[i] = float32(aMax / 127)
+					for j := 0; j < n; j++ {
+						a[i*lda+j] = int8(math.Round(a0[i*lda+j] / float64(rowScale[i])))
+					}
+				}
+				var xMax float64
+				for _, v := range x0 {
+					xMax = math.Max(xMax, math.Abs(v))
+				}
+				xScale := float32(xMax / 127)
+				x := make([]int8, lenX)
+				for i, v := range x0 {
+					x[i] = int8(math.Round(v / float64(xScale)))
+				}
+
+				// Dequantize and compute the product with Sgemv.
+				af := make([]float32, len(a))
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						af[i*lda+j] = rowScale[i] * float32(a[i*lda+j])
+					}
+				}
+				xf := make([]float32, lenX)
+				for i, v := range x {
+					xf[i] = xScale * float32(v)
+				}
+				want := make([]float32, 1+(lenY-1)*abs(incY))
+				impl.Sgemv(tA, m, n, 1, af, lda, xf, 1, 0, want, incY)
+
+				got := make([]float32, len(want))
+				impl.DgemvQuant(tA, m, n, a, lda, rowScale, x, xScale, got, incY)
+
+				for k := 0; k < lenY; k++ {
+					// Sum the magnitudes of the terms of element k, and bound
+					// the error of the quantized product relative to the
+					// product of the original data, at most half a step of
+					// each quantization.
+					var mag, quantErr, exact float64
+					for l := 0; l < lenX; l++ {
+						i, j := k, l
+						if tA != blas.NoTrans {
+							i, j = l, k
+						}
+						av, xv := a0[i*lda+j], x0[l]
+						sa, sx := float64(rowScale[i]), float64(xScale)
+						mag += math.Abs(av * xv)
+						quantErr += math.Abs(av)*sx/2 + sa/2*math.Abs(xv) + sa*sx/4
+						exact += av * xv
+					}
+					iy := vecIndex(k, lenY, incY)
+					if diff := math.Abs(float64(got[iy] - want[iy])); diff > tol*mag {
+						t.Errorf("%s: y[%d] differs from dequantized Sgemv: got %v, want %v", name, k, got[iy], want[iy])
+					}
+					if diff := math.Abs(float64(got[iy]) - exact); diff > quantErr+tol*mag {
+						t.Errorf("%s: quantization error of y[%d] too large: got %v, want within %v", name, k, diff, quantErr)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemvQuantLongRow(t *testing.T) {
+	// The products of -128 with itself sum to more than the largest int32
+	// in a row longer than quantBlock.
+	n := quantBlock + 5
+	a := make([]int8, n)
+	x := make([]int8, n)
+	for i := range a {
+		a[i] = -128
+		x[i] = -128
+	}
+	y := make([]float32, 1)
+	impl.DgemvQuant(blas.NoTrans, 1, n, a, n, []float32{1}, x, 1, y, 1)
+	if want := float32(int64(n) * 128 * 128); y[0] != want {
+		t.Errorf("unexpected result: got %v, want %v", y[0], want)
+	}
+}
+
+func TestDgemvQuantEmpty(t *testing.T) {
+	// When the inner dimension of the product is zero, y must be set to
+	// zero whatever its input values.
+	for _, test := range []struct {
+		tA   blas.Transpose
+		m, n int
+	}{
+		{blas.NoTrans, 3, 0},
+		{blas.Trans, 0, 3},
+	} {
+		for _, incY := range []int{1, -2} {
+			y := make([]float32, 1+2*abs(incY))
+			for i := range y {
+				y[i] = float32(math.NaN())
+			}
+			impl.DgemvQuant(test.tA, test.m, test.n, nil, max(1, test.n), make([]float32, test.m), nil, 1, y, incY)
+			for k := 0; k < 3; k++ {
+				iy := vecIndex(k, 3, incY)
+				if y[iy] != 0 {
+					t.Errorf("tA=%c,m=%d,n=%d,incY=%d: y[%d] = %v, want 0", test.tA, test.m, test.n, incY, k, y[iy])
+				}
+			}
+		}
+	}
+}
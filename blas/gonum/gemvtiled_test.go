@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// gemvTTile is the number of elements of y updated per pass over the rows of
+// A by dgemvTTiled. A tile of 2048 elements occupies 16 KiB and stays in the
+// L1 cache while the corresponding columns of A are streamed.
+const gemvTTile = 2048
+
+// dgemvTTiled computes
+//  y = alpha * Aᵀ * x + beta * y
+// where A is an m×n dense matrix, x is a vector, y is a vector with unit
+// increment, and alpha and beta are scalars.
+//
+// The columns of A are processed in blocks of gemvTTile, and each block of y
+// is updated by all rows of A with f64.GemvT before the next block is
+// started. Each element of y accumulates the products in the same order as in
+// a single call to f64.GemvT, so the result is identical.
+//
+// dgemvTTiled is kept with its benchmark rather than used by Dgemv. The Trans
+// case is limited by the bandwidth of streaming A rather than by writes to y,
+// and BenchmarkDgemvTTiled shows no improvement over f64.GemvT for a
+// 5000×5000 matrix on amd64, nor for a 40×2000000 matrix whose y does not fit
+// in the L2 cache; smaller tiles are slower because f64.GemvT amortizes its
+// loads of y over four rows of A only within a tile.
+func dgemvTTiled(m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64) {
+	for j0 := 0; j0 < n; j0 += gemvTTile {
+		j1 := min(n, j0+gemvTTile)
+		f64.GemvT(uintptr(m), uintptr(j1-j0), alpha, a[j0:], uintptr(lda), x, uintptr(incX), beta, y[j0:j1], 1)
+	}
+}
+
+func TestDgemvTTiled(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range [][2]int{{1, 1}, {3, 7}, {7, 3}, {10, gemvTTile - 1}, {5, gemvTTile}, {4, gemvTTile + 1}, {9, 2*gemvTTile + 13}} {
+		m, n := mn[0], mn[1]
+		for _, lda := range []int{n, n + 5} {
+			a := randvec(lda*(m-1)+n, 1, rnd)
+			for _, incX := range []int{1, 3, -2} {
+				x := randvec(m, incX, rnd)
+				y := randvec(n, 1, rnd)
+				for _, alpha := range []float64{1, -2.5} {
+					for _, beta := range []float64{0, 1, 0.5} {
+						want := make([]float64, len(y))
+						copy(want, y)
+						f64.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, want, 1)
+
+						got := make([]float64, len(y))
+						copy(got, y)
+						dgemvTTiled(m, n, alpha, a, lda, x, incX, beta, got)
+
+						for i := range want {
+							if got[i] != want[i] {
+								t.Errorf("m=%v,n=%v,lda=%v,incX=%v,alpha=%v,beta=%v: result differs from GemvT at %v: want %v, got %v",
+									m, n, lda, incX, alpha, beta, i, want[i], got[i])
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDgemvTTiled(b *testing.B) {
+	const m, n = 5000, 5000
+	rnd := rand.New(rand.NewSource(1))
+	a := randvec(m*n, 1, rnd)
+	x := randvec(m, 1, rnd)
+	y := randvec(n, 1, rnd)
+	for _, tiled := range []bool{false, true} {
+		b.Run(fmt.Sprintf("tiled=%v", tiled), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if tiled {
+					dgemvTTiled(m, n, 1, a, n, x, 1, 1, y)
+				} else {
+					f64.GemvT(m, n, 1, a, n, x, 1, 1, y, 1)
+				}
+			}
+		})
+	}
+}
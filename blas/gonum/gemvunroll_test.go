@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+func TestDgemvNUnroll4(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{1, 2, 5, 13} {
+		for _, n := range []int{1, 3, 4, 7, 8, 33} {
+			for _, lda := range []int{n, n + 3} {
+				for _, incY := range []int{1, 2, -3} {
+					for _, beta := range []float64{0, 1, -0.5} {
+						a := randmat(m, n, lda, rnd)
+						x := randvec(n, 1, rnd)
+						y := randvec(m, incY, rnd)
+
+						// Compute the reference result in strict
+						// summation order.
+						want := make([]float64, len(y))
+						copy(want, y)
+						f64.GemvN(uintptr(m), uintptr(n), 1.5, a, uintptr(lda), x, 1, beta, want, uintptr(incY))
+
+						got := make([]float64, len(y))
+						copy(got, y)
+						dgemvNUnroll4(m, n, 1.5, a, lda, x, beta, got, incY)
+
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("m=%v,n=%v,lda=%v,incY=%v,beta=%v: result differs from strict order\nwant %v\ngot  %v",
+								m, n, lda, incY, beta, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDgemvNUnroll4(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{10, 100, 1000} {
+		a := randmat(n, n, n, rnd)
+		x := randvec(n, 1, rnd)
+		y := make([]float64, n)
+		b.Run(fmt.Sprintf("Dgemv/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				impl.Dgemv(blas.NoTrans, n, n, 1, a, n, x, 1, 0, y, 1)
+			}
+		})
+		b.Run(fmt.Sprintf("dgemvNUnroll4/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dgemvNUnroll4(n, n, 1, a, n, x, 0, y, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDgemvCheckZeroX(t *testing.T) {
+	impl := Implementation{CheckZeroX: true}
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {3, 5}, {5, 3}} {
+			m, n := mn[0], mn[1]
+			for _, inc := range [][2]int{{1, 1}, {2, -3}} {
+				incX, incY := inc[0], inc[1]
+				for _, beta := range []float64{0, 1, -0.5} {
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					// A is filled with NaN so that any reference
+					// to it is detected in y.
+					a := make([]float64, m*n)
+					for i := range a {
+						a[i] = math.NaN()
+					}
+					x := make([]float64, 1+(lenX-1)*abs(incX))
+					y := randvec(lenY, incY, rnd)
+
+					want := make([]float64, len(y))
+					for i, v := range y {
+						want[i] = beta * v
+					}
+					impl.Dgemv(tA, m, n, 2, a, n, x, incX, beta, y, incY)
+
+					prefix := fmt.Sprintf("tA=%v,m=%v,n=%v,incX=%v,incY=%v,beta=%v", tA, m, n, incX, incY, beta)
+					for i := 0; i < lenY; i++ {
+						j := vecIndex(i, lenY, incY)
+						if y[j] != want[j] {
+							t.Errorf("%v: unexpected y[%v]: got %v, want %v", prefix, i, y[j], want[j])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDgemvCheckZeroX(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{10, 100, 1000} {
+		a := randmat(n, n, n, rnd)
+		x := randvec(n, 1, rnd)
+		zero := make([]float64, n)
+		// lastNonZero is the worst case for the scan of x.
+		lastNonZero := make([]float64, n)
+		lastNonZero[n-1] = 1
+		y := make([]float64, n)
+		for _, test := range []struct {
+			name  string
+			check bool
+			x     []float64
+		}{
+			{name: "NoCheck", check: false, x: x},
+			{name: "CheckNonZero", check: true, x: x},
+			{name: "CheckLastNonZero", check: true, x: lastNonZero},
+			{name: "CheckZero", check: true, x: zero},
+		} {
+			impl := Implementation{CheckZeroX: test.check}
+			b.Run(fmt.Sprintf("%s/n=%d", test.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					impl.Dgemv(blas.NoTrans, n, n, 1, a, n, test.x, 1, 0, y, 1)
+				}
+			})
+		}
+	}
+}
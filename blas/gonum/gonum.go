@@ -12,14 +12,54 @@ import (
 	"gonum.org/v1/gonum/internal/math32"
 )
 
-type Implementation struct{}
+// Implementation is a Go implementation of the BLAS API.
+type Implementation struct {
+	// Counter, if not nil, counts the multiply-adds performed
+	// by the Level 2 routines listed in the MulAddCounter
+	// documentation.
+	Counter *MulAddCounter
 
-// [SD]gemm behavior constants. These are kept here to keep them out of the
-// way during single precision code genration.
-const (
-	blockSize   = 64 // b x b matrix
-	minParBlock = 4  // minimum number of blocks needed to go parallel
-)
+	// CheckZeroX specifies whether Dgemv checks for a zero x
+	// vector and skips the matrix-vector product if it is.
+	CheckZeroX bool
+
+	// StrictPacked specifies whether Dtpmv and Dtpsv, and
+	// their single precision counterparts, panic when the
+	// packed matrix slice is longer than n*(n+1)/2, which
+	// usually indicates that dense data or the wrong n was
+	// passed.
+	StrictPacked bool
+
+	// StrictDiag specifies whether Dtrsv, and its single
+	// precision counterpart, panic before solving a system
+	// with d == blas.NonUnit whose triangular matrix has an
+	// exactly zero diagonal element, rather than filling x
+	// with infinities or NaN.
+	StrictDiag bool
+
+	// Reproducible specifies whether Ddot, Daxpy and Dgemv
+	// use kernels that round every product before it is
+	// added and accumulate in a fixed order, so that their
+	// results are bit-identical on all platforms, with or
+	// without hardware FMA.
+	Reproducible bool
+
+	// Thresholds, if not nil, holds the problem sizes at
+	// which routines switch to optimized code paths. If it is
+	// nil the values returned by DefaultThresholds are used.
+	Thresholds *Thresholds
+
+	// SkipNonFinite, if not nil, makes Dger and Dsyr, and
+	// their single precision counterparts, skip the rows of A
+	// for which the corresponding element of x is NaN or
+	// infinite instead of propagating it, and counts the
+	// skipped rows.
+	SkipNonFinite *SkipCounter
+}
+
+// [SD]gemm block size. This is kept here to keep it out of the way during
+// single precision code genration.
+const blockSize = 64 // b x b matrix
 
 func max(a, b int) int {
 	if a > b {
@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// ErrIntOverflow is returned by the integer routines when an intermediate or
+// final result cannot be represented.
+var ErrIntOverflow = errors.New("blas: integer overflow")
+
+// IgemvInt64 computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// where A is an m×n dense matrix, x and y are vectors, and alpha and beta are
+// scalars, all holding integers.
+//
+// The result is computed exactly. If any product or partial sum overflows
+// int64, IgemvInt64 returns ErrIntOverflow and y is left unchanged; this may
+// happen even when the final result is representable. Argument errors cause
+// a panic as for Dgemv.
+func (Implementation) IgemvInt64(tA blas.Transpose, m, n int, alpha int64, a []int64, lda int, x []int64, incX int, beta int64, y []int64, incY int) error {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return nil
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	lenX := m
+	lenY := n
+	if tA == blas.NoTrans {
+		lenX = n
+		lenY = m
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return nil
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// The results are formed in a temporary so that y is not modified when
+	// an overflow is found.
+	res := make([]int64, lenY)
+	iy := ky
+	for i := range res {
+		var t int64
+		if alpha != 0 {
+			var dot int64
+			ix := kx
+			for l := 0; l < lenX; l++ {
+				var aij int64
+				if tA == blas.NoTrans {
+					aij = a[i*lda+l]
+				} else {
+					aij = a[l*lda+i]
+				}
+				p, ok := mulInt64(aij, x[ix])
+				if !ok {
+					return ErrIntOverflow
+				}
+				if dot, ok = addInt64(dot, p); !ok {
+					return ErrIntOverflow
+				}
+				ix += incX
+			}
+			var ok bool
+			if t, ok = mulInt64(alpha, dot); !ok {
+				return ErrIntOverflow
+			}
+		}
+		if beta != 0 {
+			p, ok := mulInt64(beta, y[iy])
+			if !ok {
+				return ErrIntOverflow
+			}
+			if t, ok = addInt64(t, p); !ok {
+				return ErrIntOverflow
+			}
+		}
+		res[i] = t
+		iy += incY
+	}
+
+	iy = ky
+	for _, v := range res {
+		y[iy] = v
+		iy += incY
+	}
+	return nil
+}
+
+// addInt64 returns a+b and whether the sum is representable.
+func addInt64(a, b int64) (int64, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
+}
+
+// mulInt64 returns a*b and whether the product is representable.
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || p/b != a {
+		return p, false
+	}
+	return p, true
+}
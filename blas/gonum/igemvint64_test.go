@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestIgemvInt64(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	// randInt returns a random integer of up to bits bits with random sign.
+	randInt := func(bits uint) int64 {
+		v := int64(rnd.Uint64() >> (64 - bits))
+		if rnd.Intn(2) == 0 {
+			v = -v
+		}
+		return v
+	}
+	var nOverflow, nExact int
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range []struct{ m, n int }{{1, 1}, {2, 3}, {4, 1}, {5, 6}} {
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, -3}, {-1, 2}} {
+				// Small elements never overflow, large ones frequently do.
+				for _, bits := range []uint{8, 31, 33, 62} {
+					for _, ab := range []struct{ alpha, beta int64 }{{1, 0}, {-3, 1}, {0, 2}, {randInt(bits), randInt(bits)}} {
+						m, n := mn.m, mn.n
+						name := fmt.Sprintf("tA=%c,m=%d,n=%d,incX=%d,incY=%d,bits=%d,alpha=%d,beta=%d", tA, m, n, inc.x, inc.y, bits, ab.alpha, ab.beta)
+						lenX, lenY := n, m
+						if tA != blas.NoTrans {
+							lenX, lenY = m, n
+						}
+						lda := n + 1
+						a := make([]int64, m*lda)
+						for i := range a {
+							a[i] = randInt(bits)
+						}
+						x := make([]int64, 1+(lenX-1)*abs(inc.x))
+						for i := range x {
+							x[i] = randInt(bits)
+						}
+						y := make([]int64, 1+(lenY-1)*abs(inc.y))
+						for i := range y {
+							y[i] = randInt(bits)
+						}
+
+						// Form the reference with big integers in the same order
+						// of operations, noting whether anything leaves the range
+						// of int64.
+						lo, hi := big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)
+						var overflow bool
+						check := func(v *big.Int) *big.Int {
+							if v.Cmp(lo) < 0 || v.Cmp(hi) > 0 {
+								overflow = true
+							}
+							return v
+						}
+						want := make([]int64, len(y))
+						copy(want, y)
+						for i := 0; i < lenY; i++ {
+							r := new(big.Int)
+							if ab.alpha != 0 {
+								dot := new(big.Int)
+								for l := 0; l < lenX; l++ {
+									var aij int64
+									if tA == blas.NoTrans {
+										aij = a[i*lda+l]
+									} else {
+										aij = a[l*lda+i]
+									}
+									p := check(new(big.Int).Mul(big.NewInt(aij), big.NewInt(x[vecIndex(l, lenX, inc.x)])))
+									check(dot.Add(dot, p))
+								}
+								r = check(dot.Mul(dot, big.NewInt(ab.alpha)))
+							}
+							iy := vecIndex(i, lenY, inc.y)
+							if ab.beta != 0 {
+								p := check(new(big.Int).Mul(big.NewInt(ab.beta), big.NewInt(y[iy])))
+								check(r.Add(r, p))
+							}
+							want[iy] = r.Int64()
+						}
+
+						got := make([]int64, len(y))
+						copy(got, y)
+						err := impl.IgemvInt64(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, got, inc.y)
+						if overflow {
+							nOverflow++
+							if err != ErrIntOverflow {
+								t.Errorf("%s: expected overflow error, got %v", name, err)
+							}
+							want = y
+						} else {
+							nExact++
+							if err != nil {
+								t.Errorf("%s: unexpected error: %v", name, err)
+							}
+						}
+						for i := range got {
+							if got[i] != want[i] {
+								t.Errorf("%s: unexpected y[%d]: got %d, want %d", name, i, got[i], want[i])
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	if nOverflow == 0 || nExact == 0 {
+		t.Errorf("test cases do not cover both outcomes: %d overflowing, %d exact", nOverflow, nExact)
+	}
+}
+
+func TestIgemvInt64Overflow(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		alpha, beta int64
+		a, x, y     []int64
+	}{
+		{name: "product", alpha: 1, a: []int64{1 << 32}, x: []int64{1 << 31}, y: []int64{0}},
+		{name: "negated minimum", alpha: 1, a: []int64{-1}, x: []int64{math.MinInt64}, y: []int64{0}},
+		{name: "sum", alpha: 1, a: []int64{math.MaxInt64, 1}, x: []int64{1, 1}, y: []int64{0}},
+		{name: "alpha", alpha: 2, a: []int64{1 << 62}, x: []int64{1}, y: []int64{0}},
+		{name: "beta", alpha: 1, beta: 3, a: []int64{0}, x: []int64{0}, y: []int64{1 << 62}},
+		{name: "update", alpha: 1, beta: 1, a: []int64{1}, x: []int64{1}, y: []int64{math.MaxInt64}},
+		// Intermediate overflow is reported even if the result is representable.
+		{name: "partial sum", alpha: 1, a: []int64{math.MaxInt64, 1, -1}, x: []int64{1, 1, 1}, y: []int64{0}},
+	} {
+		n := len(test.a)
+		y := append([]int64(nil), test.y...)
+		err := impl.IgemvInt64(blas.NoTrans, 1, n, test.alpha, test.a, n, test.x, 1, test.beta, y, 1)
+		if err != ErrIntOverflow {
+			t.Errorf("%s: expected overflow error, got %v", test.name, err)
+		}
+		if y[0] != test.y[0] {
+			t.Errorf("%s: y modified on overflow", test.name)
+		}
+	}
+
+	// The extreme values are exact when no operation overflows.
+	y := []int64{math.MinInt64}
+	err := impl.IgemvInt64(blas.Trans, 2, 1, 1, []int64{math.MaxInt64, 1}, 1, []int64{1, -1}, 1, 1, y, 1)
+	if err != nil || y[0] != -2 {
+		t.Errorf("unexpected result: got %d with error %v, want -2", y[0], err)
+	}
+}
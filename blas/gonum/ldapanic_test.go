@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// TestLevel2LdaPanic checks that the Level 2 routines taking a leading
+// dimension panic with badLdA exactly when lda is below the minimum implied
+// by their other arguments.
+func TestLevel2LdaPanic(t *testing.T) {
+	const (
+		m, n   = 4, 5
+		k      = 2
+		kL, kU = 1, 2
+	)
+	x := make([]float64, 10)
+	y := make([]float64, 10)
+	a := make([]float64, 100)
+	for _, test := range []struct {
+		name   string
+		minLda int
+		f      func(lda int)
+	}{
+		{"Dgemv", n, func(lda int) { impl.Dgemv(blas.NoTrans, m, n, 1, a, lda, x, 1, 0, y, 1) }},
+		{"Dger", n, func(lda int) { impl.Dger(m, n, 1, x, 1, y, 1, a, lda) }},
+		{"Dgbmv", kL + kU + 1, func(lda int) { impl.Dgbmv(blas.NoTrans, m, n, kL, kU, 1, a, lda, x, 1, 0, y, 1) }},
+		{"Dtrmv", n, func(lda int) { impl.Dtrmv(blas.Upper, blas.NoTrans, blas.NonUnit, n, a, lda, x, 1) }},
+		{"Dtrsv", n, func(lda int) { impl.Dtrsv(blas.Lower, blas.Trans, blas.Unit, n, a, lda, x, 1) }},
+		{"Dsymv", n, func(lda int) { impl.Dsymv(blas.Upper, n, 1, a, lda, x, 1, 0, y, 1) }},
+		{"Dtbmv", k + 1, func(lda int) { impl.Dtbmv(blas.Upper, blas.NoTrans, blas.NonUnit, n, k, a, lda, x, 1) }},
+		{"Dtbsv", k + 1, func(lda int) { impl.Dtbsv(blas.Lower, blas.NoTrans, blas.NonUnit, n, k, a, lda, x, 1) }},
+		{"Dsbmv", k + 1, func(lda int) { impl.Dsbmv(blas.Upper, n, k, 1, a, lda, x, 1, 0, y, 1) }},
+		{"Dsyr", n, func(lda int) { impl.Dsyr(blas.Lower, n, 1, x, 1, a, lda) }},
+		{"Dsyr2", n, func(lda int) { impl.Dsyr2(blas.Upper, n, 1, x, 1, y, 1, a, lda) }},
+	} {
+		if msg := panicValue(func() { test.f(test.minLda - 1) }); msg != badLdA {
+			t.Errorf("%v: unexpected panic for lda = %v: got %v, want %q", test.name, test.minLda-1, msg, badLdA)
+		}
+		if msg := panicValue(func() { test.f(test.minLda) }); msg != nil {
+			t.Errorf("%v: unexpected panic for lda = %v: %v", test.name, test.minLda, msg)
+		}
+	}
+}
+
+// panicValue returns the value passed to panic by f, or nil if f does not
+// panic.
+func panicValue(f func()) (v interface{}) {
+	defer func() { v = recover() }()
+	f()
+	return nil
+}
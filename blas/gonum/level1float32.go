@@ -213,9 +213,12 @@ func (Implementation) Scopy(n int, x []float32, incX int, y []float32, incY int)
 
 // Saxpy adds alpha times x to y
 //  y[i] += alpha * x[i] for all i
+// On architectures with hardware fused multiply-add support the update of
+// each element is computed with a fused multiply-add, unless the
+// Reproducible field of the receiver is true.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
+func (impl Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
 	if incX == 0 {
 		panic(zeroIncX)
 	}
@@ -237,7 +240,22 @@ func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []flo
 	if alpha == 0 {
 		return
 	}
+	if impl.Reproducible {
+		var ix, iy int
+		if incX < 0 {
+			ix = (-n + 1) * incX
+		}
+		if incY < 0 {
+			iy = (-n + 1) * incY
+		}
+		saxpyStrict(n, alpha, x, incX, ix, y, incY, iy)
+		return
+	}
 	if incX == 1 && incY == 1 {
+		if useFMA {
+			f32.AxpyUnitaryFMA(alpha, x[:n], y[:n])
+			return
+		}
 		f32.AxpyUnitary(alpha, x[:n], y[:n])
 		return
 	}
@@ -248,6 +266,10 @@ func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []flo
 	if incY < 0 {
 		iy = (-n + 1) * incY
 	}
+	if useFMA {
+		f32.AxpyIncFMA(alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
+		return
+	}
 	f32.AxpyInc(alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
 }
 
@@ -14,7 +14,7 @@ import (
 //  \sum_i x[i]*y[i]
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
+func (impl Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
 	if incX == 0 {
 		panic(zeroIncX)
 	}
@@ -34,6 +34,9 @@ func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int)
 		if len(y) < n {
 			panic(shortY)
 		}
+		if impl.Reproducible {
+			return dsdotStrict(n, x, 1, 0, y, 1, 0)
+		}
 		return f32.DdotUnitary(x[:n], y[:n])
 	}
 	var ix, iy int
@@ -49,5 +52,8 @@ func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int)
 	if iy >= len(y) || iy+(n-1)*incY >= len(y) {
 		panic(shortY)
 	}
+	if impl.Reproducible {
+		return dsdotStrict(n, x, incX, ix, y, incY, iy)
+	}
 	return f32.DdotInc(x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
 }
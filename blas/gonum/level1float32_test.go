@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// TestSdotSaxpyMatchFloat64 checks that the generated float32 Sdot and Saxpy
+// have not diverged from their float64 sources by comparing them against
+// Ddot and Daxpy on small integer data for which both precisions are exact.
+func TestSdotSaxpyMatchFloat64(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 15, 16, 17, 100} {
+		for _, inc := range [][2]int{{1, 1}, {2, 3}, {-3, 2}, {-2, -4}} {
+			incX, incY := inc[0], inc[1]
+			prefix := fmt.Sprintf("n=%v,incX=%v,incY=%v", n, incX, incY)
+
+			x64 := make([]float64, 1+max(n-1, 0)*abs(incX))
+			y64 := make([]float64, 1+max(n-1, 0)*abs(incY))
+			x32 := make([]float32, len(x64))
+			y32 := make([]float32, len(y64))
+			for i := range x64 {
+				x64[i] = float64(rnd.Intn(21) - 10)
+				x32[i] = float32(x64[i])
+			}
+			for i := range y64 {
+				y64[i] = float64(rnd.Intn(21) - 10)
+				y32[i] = float32(y64[i])
+			}
+
+			dot64 := impl.Ddot(n, x64, incX, y64, incY)
+			dot32 := impl.Sdot(n, x32, incX, y32, incY)
+			if float64(dot32) != dot64 {
+				t.Errorf("%v: Sdot differs from Ddot: got %v, want %v", prefix, dot32, dot64)
+			}
+
+			impl.Daxpy(n, 3, x64, incX, y64, incY)
+			impl.Saxpy(n, 3, x32, incX, y32, incY)
+			for i, v := range y64 {
+				if float64(y32[i]) != v {
+					t.Errorf("%v: Saxpy differs from Daxpy at %v: got %v, want %v", prefix, i, y32[i], v)
+					break
+				}
+			}
+		}
+	}
+}
@@ -201,7 +201,10 @@ func (Implementation) Dcopy(n int, x []float64, incX int, y []float64, incY int)
 
 // Daxpy adds alpha times x to y
 //  y[i] += alpha * x[i] for all i
-func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {
+// On architectures with hardware fused multiply-add support the update of
+// each element is computed with a fused multiply-add, unless the
+// Reproducible field of the receiver is true.
+func (impl Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {
 	if incX == 0 {
 		panic(zeroIncX)
 	}
@@ -223,7 +226,22 @@ func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []flo
 	if alpha == 0 {
 		return
 	}
+	if impl.Reproducible {
+		var ix, iy int
+		if incX < 0 {
+			ix = (-n + 1) * incX
+		}
+		if incY < 0 {
+			iy = (-n + 1) * incY
+		}
+		axpyStrict(n, alpha, x, incX, ix, y, incY, iy)
+		return
+	}
 	if incX == 1 && incY == 1 {
+		if useFMA {
+			f64.AxpyUnitaryFMA(alpha, x[:n], y[:n])
+			return
+		}
 		f64.AxpyUnitary(alpha, x[:n], y[:n])
 		return
 	}
@@ -234,6 +252,10 @@ func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []flo
 	if incY < 0 {
 		iy = (-n + 1) * incY
 	}
+	if useFMA {
+		f64.AxpyIncFMA(alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
+		return
+	}
 	f64.AxpyInc(alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
 }
 
@@ -10,7 +10,7 @@ import (
 
 // Ddot computes the dot product of the two vectors
 //  \sum_i x[i]*y[i]
-func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int) float64 {
+func (impl Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int) float64 {
 	if incX == 0 {
 		panic(zeroIncX)
 	}
@@ -30,6 +30,9 @@ func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int)
 		if len(y) < n {
 			panic(shortY)
 		}
+		if impl.Reproducible {
+			return dotStrict(n, x, 1, 0, y, 1, 0)
+		}
 		return f64.DotUnitary(x[:n], y[:n])
 	}
 	var ix, iy int
@@ -45,5 +48,8 @@ func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int)
 	if iy >= len(y) || iy+(n-1)*incY >= len(y) {
 		panic(shortY)
 	}
+	if impl.Reproducible {
+		return dotStrict(n, x, incX, ix, y, incY, iy)
+	}
 	return f64.DotInc(x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
 }
@@ -7,6 +7,8 @@
 package gonum
 
 import (
+	"fmt"
+
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/internal/asm/f32"
 )
@@ -17,8 +19,15 @@ var _ blas.Float32Level2 = Implementation{}
 //  A += alpha * x * yᵀ
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
 //
+// x and y are only read, so the same slice may be passed for both. With m == n
+// and incX == incY this forms the symmetric update A += alpha * x * xᵀ.
+//
+// If the SkipNonFinite field of the receiver is not nil, the rows of A for
+// which the corresponding element of x is NaN or infinite are left unchanged
+// and counted in SkipNonFinite. Non-finite elements of y still propagate.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
+func (impl Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
 	if m < 0 {
 		panic(mLT0)
 	}
@@ -40,6 +49,10 @@ func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []f
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), generalMaxIndex(m, n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
 		panic(shortX)
@@ -55,6 +68,33 @@ func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []f
 	if alpha == 0 {
 		return
 	}
+
+	skip := impl.SkipNonFinite != nil
+	if skip {
+		// The Ger kernel updates every row of A, so skipped rows are handled here.
+		var kx, ky int
+		if incX < 0 {
+			kx = -(m - 1) * incX
+		}
+		if incY < 0 {
+			ky = -(n - 1) * incY
+		}
+		var skipped int
+		ix := kx
+		for i := 0; i < m; i++ {
+			// x[ix]-x[ix] is zero unless x[ix] is NaN or infinite.
+			if x[ix]-x[ix] != 0 {
+				skipped++
+			} else {
+				f32.AxpyInc(alpha*x[ix], y, a[i*lda:i*lda+n], uintptr(n), uintptr(incY), 1, uintptr(ky), 0)
+			}
+			ix += incX
+		}
+		impl.SkipNonFinite.add(skipped)
+		impl.Counter.add((m - skipped) * n)
+		return
+	}
+	impl.Counter.add(m * n)
 	f32.Ger(uintptr(m), uintptr(n),
 		alpha,
 		x, uintptr(incX),
@@ -69,7 +109,7 @@ func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []f
 // x and y are vectors, and alpha and beta are scalars.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+func (impl Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
 	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
 		panic(badTranspose)
 	}
@@ -100,6 +140,10 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), bandMaxIndex(m, n, kL, kU, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
 		panic(shortA)
@@ -131,102 +175,22 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 	}
 
 	// Form y = beta * y.
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:lenY] {
-					y[i] = 0
-				}
-			} else {
-				f32.ScalUnitary(beta, y[:lenY])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < lenY; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f32.ScalInc(beta, y, uintptr(lenY), uintptr(incY))
-				} else {
-					f32.ScalInc(beta, y, uintptr(lenY), uintptr(-incY))
-				}
-			}
-		}
-	}
+	sscalePrepY(lenY, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
-	// i and j are indices of the compacted banded matrix.
-	// off is the offset into the dense matrix (off + j = densej)
-	nCol := kU + 1 + kL
-	if tA == blas.NoTrans {
-		iy := ky
-		if incX == 1 {
-			for i := 0; i < min(m, n+kL); i++ {
-				l := max(0, kL-i)
-				u := min(nCol, n+kL-i)
-				off := max(0, i-kL)
-				atmp := a[i*lda+l : i*lda+u]
-				xtmp := x[off : off+u-l]
-				var sum float32
-				for j, v := range atmp {
-					sum += xtmp[j] * v
-				}
-				y[iy] += sum * alpha
-				iy += incY
-			}
-			return
-		}
-		for i := 0; i < min(m, n+kL); i++ {
-			l := max(0, kL-i)
-			u := min(nCol, n+kL-i)
-			off := max(0, i-kL)
-			atmp := a[i*lda+l : i*lda+u]
-			jx := kx
-			var sum float32
-			for _, v := range atmp {
-				sum += x[off*incX+jx] * v
-				jx += incX
-			}
-			y[iy] += sum * alpha
-			iy += incY
-		}
-		return
+	if impl.Counter != nil {
+		// bandElements is O(m), so avoid it when not counting.
+		impl.Counter.add(bandElements(m, n, kL, kU))
 	}
-	if incX == 1 {
-		for i := 0; i < min(m, n+kL); i++ {
-			l := max(0, kL-i)
-			u := min(nCol, n+kL-i)
-			off := max(0, i-kL)
-			atmp := a[i*lda+l : i*lda+u]
-			tmp := alpha * x[i]
-			jy := ky
-			for _, v := range atmp {
-				y[jy+off*incY] += tmp * v
-				jy += incY
-			}
-		}
+
+	if impl.useTridiagGbmv(n, kL, kU) {
+		sgbmvTridiag(tA, m, n, alpha, a, lda, x, incX, kx, y, incY, ky)
 		return
 	}
-	ix := kx
-	for i := 0; i < min(m, n+kL); i++ {
-		l := max(0, kL-i)
-		u := min(nCol, n+kL-i)
-		off := max(0, i-kL)
-		atmp := a[i*lda+l : i*lda+u]
-		tmp := alpha * x[ix]
-		jy := ky
-		for _, v := range atmp {
-			y[jy+off*incY] += tmp * v
-			jy += incY
-		}
-		ix += incX
-	}
+	sgbmvBand(tA, m, n, kL, kU, alpha, a, lda, x, incX, kx, y, incY, ky)
 }
 
 // Strmv performs one of the matrix-vector operations
@@ -260,6 +224,10 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularMaxIndex(n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
 		panic(shortA)
@@ -394,8 +362,12 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 //
+// If the StrictDiag field of the receiver is true and d == blas.NonUnit,
+// Strsv panics before the solve if a diagonal element of A is exactly zero,
+// reporting the zero-based index of the first such element.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
+func (impl Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -420,6 +392,10 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularMaxIndex(n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
 		panic(shortA)
@@ -428,6 +404,14 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		panic(shortX)
 	}
 
+	if impl.StrictDiag && d == blas.NonUnit {
+		for i := 0; i < n; i++ {
+			if a[i*lda+i] == 0 {
+				panic(fmt.Sprintf("%s at index %d", zeroDiag, i))
+			}
+		}
+	}
+
 	if n == 1 {
 		if d == blas.NonUnit {
 			x[0] /= a[0]
@@ -571,8 +555,12 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha and
 // beta are scalars.
 //
+// When incX and incY are both 1 and n is at least the SymvUnitary threshold,
+// the off-diagonal work is done by the Level 1 Axpy and Dot kernels, so the
+// result may differ in the last bits from that of the strided code.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+func (impl Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -594,6 +582,10 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularMaxIndex(n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
 		panic(shortA)
@@ -619,43 +611,32 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 		ky = -(n - 1) * incY
 	}
 
-	// Form y = beta * y
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:n] {
-					y[i] = 0
-				}
-			} else {
-				f32.ScalUnitary(beta, y[:n])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < n; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f32.ScalInc(beta, y, uintptr(n), uintptr(incY))
-				} else {
-					f32.ScalInc(beta, y, uintptr(n), uintptr(-incY))
-				}
-			}
-		}
-	}
+	// Form y = beta * y.
+	sscalePrepY(n, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
+	impl.Counter.add(n * n)
+
 	if n == 1 {
 		y[0] += alpha * a[0] * x[0]
 		return
 	}
 
 	if ul == blas.Upper {
+		if impl.useUnitarySymv(n, incX, incY) {
+			// The off-diagonal part of row i is spread into y and dotted
+			// with x by the Level 1 kernels.
+			for i := 0; i < n; i++ {
+				atmp := a[i*lda+i+1 : i*lda+n]
+				f32.AxpyUnitary(alpha*x[i], atmp, y[i+1:n])
+				sum := x[i]*a[i*lda+i] + f32.DotUnitary(atmp, x[i+1:n])
+				y[i] += alpha * sum
+			}
+			return
+		}
 		if incX == 1 {
 			iy := ky
 			for i := 0; i < n; i++ {
@@ -695,6 +676,15 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 		return
 	}
 	// Cases where a is lower triangular.
+	if impl.useUnitarySymv(n, incX, incY) {
+		for i := 0; i < n; i++ {
+			atmp := a[i*lda : i*lda+i]
+			f32.AxpyUnitary(alpha*x[i], atmp, y[:i])
+			sum := f32.DotUnitary(atmp, x[:i]) + x[i]*a[i*lda+i]
+			y[i] += alpha * sum
+		}
+		return
+	}
 	if incX == 1 {
 		iy := ky
 		for i := 0; i < n; i++ {
@@ -770,6 +760,10 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularBandMaxIndex(ul, n, k, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
 		panic(shortA)
@@ -952,8 +946,11 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular matrix in packed format, and x is a vector.
 //
+// If the StrictPacked field of the receiver is true and n > 0, Stpmv panics
+// unless len(ap) is exactly n*(n+1)/2.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float32, x []float32, incX int) {
+func (impl Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float32, x []float32, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -975,10 +972,17 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(ap), packedMaxIndex(n), shortAP)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
 		panic(shortAP)
 	}
+	if impl.StrictPacked && len(ap) != n*(n+1)/2 {
+		panic(badLenAP)
+	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
 		panic(shortX)
 	}
@@ -994,11 +998,11 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		if ul == blas.Upper {
 			if incX == 1 {
 				for i := 0; i < n; i++ {
+					atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 					xi := x[i]
 					if nonUnit {
-						xi *= ap[offset]
+						xi *= aii
 					}
-					atmp := ap[offset+1 : offset+n-i]
 					xtmp := x[i+1:]
 					for j, v := range atmp {
 						xi += v * xtmp[j]
@@ -1010,11 +1014,11 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			}
 			ix := kx
 			for i := 0; i < n; i++ {
+				atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 				xix := x[ix]
 				if nonUnit {
-					xix *= ap[offset]
+					xix *= aii
 				}
-				atmp := ap[offset+1 : offset+n-i]
 				jx := kx + (i+1)*incX
 				for _, v := range atmp {
 					xix += v * x[jx]
@@ -1029,11 +1033,11 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		if incX == 1 {
 			offset = n*(n+1)/2 - 1
 			for i := n - 1; i >= 0; i-- {
+				atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 				xi := x[i]
 				if nonUnit {
-					xi *= ap[offset]
+					xi *= aii
 				}
-				atmp := ap[offset-i : offset]
 				for j, v := range atmp {
 					xi += v * x[j]
 				}
@@ -1045,11 +1049,11 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		ix := kx + (n-1)*incX
 		offset = n*(n+1)/2 - 1
 		for i := n - 1; i >= 0; i-- {
+			atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 			xix := x[ix]
 			if nonUnit {
-				xix *= ap[offset]
+				xix *= aii
 			}
-			atmp := ap[offset-i : offset]
 			jx := kx
 			for _, v := range atmp {
 				xix += v * x[jx]
@@ -1066,14 +1070,14 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		if incX == 1 {
 			offset = n*(n+1)/2 - 1
 			for i := n - 1; i >= 0; i-- {
+				atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 				xi := x[i]
-				atmp := ap[offset+1 : offset+n-i]
 				xtmp := x[i+1:]
 				for j, v := range atmp {
 					xtmp[j] += v * xi
 				}
 				if nonUnit {
-					x[i] *= ap[offset]
+					x[i] *= aii
 				}
 				offset -= n - i + 1
 			}
@@ -1082,15 +1086,15 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		ix := kx + (n-1)*incX
 		offset = n*(n+1)/2 - 1
 		for i := n - 1; i >= 0; i-- {
+			atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 			xix := x[ix]
 			jx := kx + (i+1)*incX
-			atmp := ap[offset+1 : offset+n-i]
 			for _, v := range atmp {
 				x[jx] += v * xix
 				jx += incX
 			}
 			if nonUnit {
-				x[ix] *= ap[offset]
+				x[ix] *= aii
 			}
 			offset -= n - i + 1
 			ix -= incX
@@ -1099,13 +1103,13 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	}
 	if incX == 1 {
 		for i := 0; i < n; i++ {
+			atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 			xi := x[i]
-			atmp := ap[offset-i : offset]
 			for j, v := range atmp {
 				x[j] += v * xi
 			}
 			if nonUnit {
-				x[i] *= ap[offset]
+				x[i] *= aii
 			}
 			offset += i + 2
 		}
@@ -1113,15 +1117,15 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	}
 	ix := kx
 	for i := 0; i < n; i++ {
+		atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 		xix := x[ix]
 		jx := kx
-		atmp := ap[offset-i : offset]
 		for _, v := range atmp {
 			x[jx] += v * xix
 			jx += incX
 		}
 		if nonUnit {
-			x[ix] *= ap[offset]
+			x[ix] *= aii
 		}
 		ix += incX
 		offset += i + 2
@@ -1169,6 +1173,10 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularBandMaxIndex(ul, n, k, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
 		panic(shortA)
@@ -1358,7 +1366,7 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 // vectors, and alpha and beta are scalars.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+func (impl Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1411,36 +1419,17 @@ func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32,
 	}
 
 	// Form y = beta * y.
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:n] {
-					y[i] = 0
-				}
-			} else {
-				f32.ScalUnitary(beta, y[:n])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < n; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f32.ScalInc(beta, y, uintptr(n), uintptr(incY))
-				} else {
-					f32.ScalInc(beta, y, uintptr(n), uintptr(-incY))
-				}
-			}
-		}
-	}
+	sscalePrepY(n, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
+	if impl.Counter != nil {
+		// bandElements is O(m), so avoid it when not counting.
+		impl.Counter.add(bandElements(n, n, min(k, n-1), min(k, n-1)))
+	}
+
 	if ul == blas.Upper {
 		if incX == 1 {
 			iy := ky
@@ -1528,8 +1517,12 @@ func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32,
 //  A += alpha * x * xᵀ
 // where A is an n×n symmetric matrix, and x is a vector.
 //
+// If the SkipNonFinite field of the receiver is not nil, the elements of x
+// that are NaN or infinite are treated as zero, so that the corresponding rows
+// and columns of A are left unchanged, and they are counted in SkipNonFinite.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, a []float32, lda int) {
+func (impl Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, a []float32, lda int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1561,6 +1554,29 @@ func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 		return
 	}
 
+	if impl.SkipNonFinite != nil {
+		// Copy x with its NaN and infinite elements set to zero, so that
+		// the corresponding rows and columns of A are left unchanged.
+		// x[ix]-x[ix] is zero unless x[ix] is NaN or infinite.
+		var skipped int
+		tmp := make([]float32, n)
+		ix := 0
+		if incX < 0 {
+			ix = -(n - 1) * incX
+		}
+		for i := range tmp {
+			if x[ix]-x[ix] == 0 {
+				tmp[i] = x[ix]
+			} else {
+				skipped++
+			}
+			ix += incX
+		}
+		impl.SkipNonFinite.add(skipped)
+		x = tmp
+		incX = 1
+	}
+
 	lenX := n
 	var kx int
 	if incX < 0 {
@@ -1628,6 +1644,12 @@ func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 //  A += alpha * x * yᵀ + alpha * y * xᵀ
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
 //
+// Each referenced element A[i][j] is updated as
+//  A[i][j] += alpha * (x[i]*y[j] + x[j]*y[i])
+// with every product and sum rounded to float32, so the two cross terms are
+// always added in this order and the result does not depend on whether the
+// platform fuses multiply-add operations.
+//
 // Float32 implementations are autogenerated and not directly tested.
 func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
 	if ul != blas.Lower && ul != blas.Upper {
@@ -1681,7 +1703,7 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 				yi := y[i]
 				atmp := a[i*lda:]
 				for j := i; j < n; j++ {
-					atmp[j] += alpha * (xi*y[j] + x[j]*yi)
+					atmp[j] += float32(alpha * (float32(xi*y[j]) + float32(x[j]*yi)))
 				}
 			}
 			return
@@ -1695,7 +1717,7 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 			yi := y[iy]
 			atmp := a[i*lda:]
 			for j := i; j < n; j++ {
-				atmp[j] += alpha * (xi*y[jy] + x[jx]*yi)
+				atmp[j] += float32(alpha * (float32(xi*y[jy]) + float32(x[jx]*yi)))
 				jx += incX
 				jy += incY
 			}
@@ -1710,7 +1732,7 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 			yi := y[i]
 			atmp := a[i*lda:]
 			for j := 0; j <= i; j++ {
-				atmp[j] += alpha * (xi*y[j] + x[j]*yi)
+				atmp[j] += float32(alpha * (float32(xi*y[j]) + float32(x[j]*yi)))
 			}
 		}
 		return
@@ -1724,7 +1746,7 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 		yi := y[iy]
 		atmp := a[i*lda:]
 		for j := 0; j <= i; j++ {
-			atmp[j] += alpha * (xi*y[jy] + x[jx]*yi)
+			atmp[j] += float32(alpha * (float32(xi*y[jy]) + float32(x[jx]*yi)))
 			jx += incX
 			jy += incY
 		}
@@ -1744,8 +1766,11 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 //
+// If the StrictPacked field of the receiver is true and n > 0, Stpsv panics
+// unless len(ap) is exactly n*(n+1)/2.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float32, x []float32, incX int) {
+func (impl Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float32, x []float32, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1767,10 +1792,17 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(ap), packedMaxIndex(n), shortAP)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
 		panic(shortAP)
 	}
+	if impl.StrictPacked && len(ap) != n*(n+1)/2 {
+		panic(badLenAP)
+	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
 		panic(shortX)
 	}
@@ -1787,7 +1819,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			offset = n*(n+1)/2 - 1
 			if incX == 1 {
 				for i := n - 1; i >= 0; i-- {
-					atmp := ap[offset+1 : offset+n-i]
+					atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 					xtmp := x[i+1:]
 					var sum float32
 					for j, v := range atmp {
@@ -1795,7 +1827,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 					}
 					x[i] -= sum
 					if nonUnit {
-						x[i] /= ap[offset]
+						x[i] /= aii
 					}
 					offset -= n - i + 1
 				}
@@ -1803,7 +1835,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			}
 			ix := kx + (n-1)*incX
 			for i := n - 1; i >= 0; i-- {
-				atmp := ap[offset+1 : offset+n-i]
+				atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 				jx := kx + (i+1)*incX
 				var sum float32
 				for _, v := range atmp {
@@ -1812,7 +1844,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 				}
 				x[ix] -= sum
 				if nonUnit {
-					x[ix] /= ap[offset]
+					x[ix] /= aii
 				}
 				ix -= incX
 				offset -= n - i + 1
@@ -1821,14 +1853,14 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		}
 		if incX == 1 {
 			for i := 0; i < n; i++ {
-				atmp := ap[offset-i : offset]
+				atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 				var sum float32
 				for j, v := range atmp {
 					sum += v * x[j]
 				}
 				x[i] -= sum
 				if nonUnit {
-					x[i] /= ap[offset]
+					x[i] /= aii
 				}
 				offset += i + 2
 			}
@@ -1836,8 +1868,8 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		}
 		ix := kx
 		for i := 0; i < n; i++ {
+			atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 			jx := kx
-			atmp := ap[offset-i : offset]
 			var sum float32
 			for _, v := range atmp {
 				sum += v * x[jx]
@@ -1845,7 +1877,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			}
 			x[ix] -= sum
 			if nonUnit {
-				x[ix] /= ap[offset]
+				x[ix] /= aii
 			}
 			ix += incX
 			offset += i + 2
@@ -1856,11 +1888,11 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if ul == blas.Upper {
 		if incX == 1 {
 			for i := 0; i < n; i++ {
+				atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 				if nonUnit {
-					x[i] /= ap[offset]
+					x[i] /= aii
 				}
 				xi := x[i]
-				atmp := ap[offset+1 : offset+n-i]
 				xtmp := x[i+1:]
 				for j, v := range atmp {
 					xtmp[j] -= v * xi
@@ -1871,11 +1903,11 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		}
 		ix := kx
 		for i := 0; i < n; i++ {
+			atmp, aii := spackedRow(ap, i, offset, offset+1, offset+n-i)
 			if nonUnit {
-				x[ix] /= ap[offset]
+				x[ix] /= aii
 			}
 			xix := x[ix]
-			atmp := ap[offset+1 : offset+n-i]
 			jx := kx + (i+1)*incX
 			for _, v := range atmp {
 				x[jx] -= v * xix
@@ -1889,11 +1921,11 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if incX == 1 {
 		offset = n*(n+1)/2 - 1
 		for i := n - 1; i >= 0; i-- {
+			atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 			if nonUnit {
-				x[i] /= ap[offset]
+				x[i] /= aii
 			}
 			xi := x[i]
-			atmp := ap[offset-i : offset]
 			for j, v := range atmp {
 				x[j] -= v * xi
 			}
@@ -1904,11 +1936,11 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	ix := kx + (n-1)*incX
 	offset = n*(n+1)/2 - 1
 	for i := n - 1; i >= 0; i-- {
+		atmp, aii := spackedRow(ap, i, offset, offset-i, offset)
 		if nonUnit {
-			x[ix] /= ap[offset]
+			x[ix] /= aii
 		}
 		xix := x[ix]
-		atmp := ap[offset-i : offset]
 		jx := kx
 		for _, v := range atmp {
 			x[jx] -= v * xix
@@ -1924,8 +1956,12 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha and beta are scalars.
 //
+// When incX and incY are both 1 and n is at least the SymvUnitary threshold,
+// the off-diagonal work is done by the Level 1 Axpy and Dot kernels, so the
+// result may differ in the last bits from that of the strided code.
+//
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap []float32, x []float32, incX int, beta float32, y []float32, incY int) {
+func (impl Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap []float32, x []float32, incX int, beta float32, y []float32, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1970,31 +2006,7 @@ func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap []float32, x
 	}
 
 	// Form y = beta * y.
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:n] {
-					y[i] = 0
-				}
-			} else {
-				f32.ScalUnitary(beta, y[:n])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < n; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f32.ScalInc(beta, y, uintptr(n), uintptr(incY))
-				} else {
-					f32.ScalInc(beta, y, uintptr(n), uintptr(-incY))
-				}
-			}
-		}
-	}
+	sscalePrepY(n, beta, y, incY)
 
 	if alpha == 0 {
 		return
@@ -2006,6 +2018,18 @@ func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap []float32, x
 	}
 	var offset int // Offset is the index of (i,i).
 	if ul == blas.Upper {
+		if impl.useUnitarySymv(n, incX, incY) {
+			// The off-diagonal part of row i is spread into y and dotted
+			// with x by the Level 1 kernels.
+			for i := 0; i < n; i++ {
+				atmp := ap[offset+1 : offset+n-i]
+				f32.AxpyUnitary(alpha*x[i], atmp, y[i+1:n])
+				sum := ap[offset]*x[i] + f32.DotUnitary(atmp, x[i+1:n])
+				y[i] += alpha * sum
+				offset += n - i
+			}
+			return
+		}
 		if incX == 1 {
 			iy := ky
 			for i := 0; i < n; i++ {
@@ -2046,6 +2070,16 @@ func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap []float32, x
 		}
 		return
 	}
+	if impl.useUnitarySymv(n, incX, incY) {
+		for i := 0; i < n; i++ {
+			atmp := ap[offset-i : offset]
+			f32.AxpyUnitary(alpha*x[i], atmp, y[:i])
+			sum := f32.DotUnitary(atmp, x[:i]) + ap[offset]*x[i]
+			y[i] += alpha * sum
+			offset += i + 2
+		}
+		return
+	}
 	if incX == 1 {
 		iy := ky
 		for i := 0; i < n; i++ {
@@ -5,6 +5,8 @@
 package gonum
 
 import (
+	"fmt"
+
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/internal/asm/f64"
 )
@@ -14,7 +16,14 @@ var _ blas.Float64Level2 = Implementation{}
 // Dger performs the rank-one operation
 //  A += alpha * x * yᵀ
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
-func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+//
+// x and y are only read, so the same slice may be passed for both. With m == n
+// and incX == incY this forms the symmetric update A += alpha * x * xᵀ.
+//
+// If the SkipNonFinite field of the receiver is not nil, the rows of A for
+// which the corresponding element of x is NaN or infinite are left unchanged
+// and counted in SkipNonFinite. Non-finite elements of y still propagate.
+func (impl Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
 	if m < 0 {
 		panic(mLT0)
 	}
@@ -36,6 +45,10 @@ func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []f
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), generalMaxIndex(m, n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
 		panic(shortX)
@@ -51,6 +64,33 @@ func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []f
 	if alpha == 0 {
 		return
 	}
+
+	skip := impl.SkipNonFinite != nil
+	if skip {
+		// The Ger kernel updates every row of A, so skipped rows are handled here.
+		var kx, ky int
+		if incX < 0 {
+			kx = -(m - 1) * incX
+		}
+		if incY < 0 {
+			ky = -(n - 1) * incY
+		}
+		var skipped int
+		ix := kx
+		for i := 0; i < m; i++ {
+			// x[ix]-x[ix] is zero unless x[ix] is NaN or infinite.
+			if x[ix]-x[ix] != 0 {
+				skipped++
+			} else {
+				f64.AxpyInc(alpha*x[ix], y, a[i*lda:i*lda+n], uintptr(n), uintptr(incY), 1, uintptr(ky), 0)
+			}
+			ix += incX
+		}
+		impl.SkipNonFinite.add(skipped)
+		impl.Counter.add((m - skipped) * n)
+		return
+	}
+	impl.Counter.add(m * n)
 	f64.Ger(uintptr(m), uintptr(n),
 		alpha,
 		x, uintptr(incX),
@@ -63,7 +103,7 @@ func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []f
 //  y = alpha * Aᵀ * x + beta * y  if tA == blas.Trans or blas.ConjTrans
 // where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
 // x and y are vectors, and alpha and beta are scalars.
-func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+func (impl Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
 		panic(badTranspose)
 	}
@@ -94,6 +134,10 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), bandMaxIndex(m, n, kL, kU, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
 		panic(shortA)
@@ -125,102 +169,22 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 	}
 
 	// Form y = beta * y.
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:lenY] {
-					y[i] = 0
-				}
-			} else {
-				f64.ScalUnitary(beta, y[:lenY])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < lenY; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f64.ScalInc(beta, y, uintptr(lenY), uintptr(incY))
-				} else {
-					f64.ScalInc(beta, y, uintptr(lenY), uintptr(-incY))
-				}
-			}
-		}
-	}
+	dscalePrepY(lenY, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
-	// i and j are indices of the compacted banded matrix.
-	// off is the offset into the dense matrix (off + j = densej)
-	nCol := kU + 1 + kL
-	if tA == blas.NoTrans {
-		iy := ky
-		if incX == 1 {
-			for i := 0; i < min(m, n+kL); i++ {
-				l := max(0, kL-i)
-				u := min(nCol, n+kL-i)
-				off := max(0, i-kL)
-				atmp := a[i*lda+l : i*lda+u]
-				xtmp := x[off : off+u-l]
-				var sum float64
-				for j, v := range atmp {
-					sum += xtmp[j] * v
-				}
-				y[iy] += sum * alpha
-				iy += incY
-			}
-			return
-		}
-		for i := 0; i < min(m, n+kL); i++ {
-			l := max(0, kL-i)
-			u := min(nCol, n+kL-i)
-			off := max(0, i-kL)
-			atmp := a[i*lda+l : i*lda+u]
-			jx := kx
-			var sum float64
-			for _, v := range atmp {
-				sum += x[off*incX+jx] * v
-				jx += incX
-			}
-			y[iy] += sum * alpha
-			iy += incY
-		}
-		return
+	if impl.Counter != nil {
+		// bandElements is O(m), so avoid it when not counting.
+		impl.Counter.add(bandElements(m, n, kL, kU))
 	}
-	if incX == 1 {
-		for i := 0; i < min(m, n+kL); i++ {
-			l := max(0, kL-i)
-			u := min(nCol, n+kL-i)
-			off := max(0, i-kL)
-			atmp := a[i*lda+l : i*lda+u]
-			tmp := alpha * x[i]
-			jy := ky
-			for _, v := range atmp {
-				y[jy+off*incY] += tmp * v
-				jy += incY
-			}
-		}
+
+	if impl.useTridiagGbmv(n, kL, kU) {
+		dgbmvTridiag(tA, m, n, alpha, a, lda, x, incX, kx, y, incY, ky)
 		return
 	}
-	ix := kx
-	for i := 0; i < min(m, n+kL); i++ {
-		l := max(0, kL-i)
-		u := min(nCol, n+kL-i)
-		off := max(0, i-kL)
-		atmp := a[i*lda+l : i*lda+u]
-		tmp := alpha * x[ix]
-		jy := ky
-		for _, v := range atmp {
-			y[jy+off*incY] += tmp * v
-			jy += incY
-		}
-		ix += incX
-	}
+	dgbmvBand(tA, m, n, kL, kU, alpha, a, lda, x, incX, kx, y, incY, ky)
 }
 
 // Dtrmv performs one of the matrix-vector operations
@@ -252,6 +216,10 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularMaxIndex(n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
 		panic(shortA)
@@ -385,7 +353,11 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
-func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
+//
+// If the StrictDiag field of the receiver is true and d == blas.NonUnit,
+// Dtrsv panics before the solve if a diagonal element of A is exactly zero,
+// reporting the zero-based index of the first such element.
+func (impl Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -410,6 +382,10 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularMaxIndex(n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
 		panic(shortA)
@@ -418,6 +394,14 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		panic(shortX)
 	}
 
+	if impl.StrictDiag && d == blas.NonUnit {
+		for i := 0; i < n; i++ {
+			if a[i*lda+i] == 0 {
+				panic(fmt.Sprintf("%s at index %d", zeroDiag, i))
+			}
+		}
+	}
+
 	if n == 1 {
 		if d == blas.NonUnit {
 			x[0] /= a[0]
@@ -560,7 +544,11 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //  y = alpha * A * x + beta * y
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha and
 // beta are scalars.
-func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+//
+// When incX and incY are both 1 and n is at least the SymvUnitary threshold,
+// the off-diagonal work is done by the Level 1 Axpy and Dot kernels, so the
+// result may differ in the last bits from that of the strided code.
+func (impl Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -582,6 +570,10 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularMaxIndex(n, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
 		panic(shortA)
@@ -607,43 +599,32 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 		ky = -(n - 1) * incY
 	}
 
-	// Form y = beta * y
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:n] {
-					y[i] = 0
-				}
-			} else {
-				f64.ScalUnitary(beta, y[:n])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < n; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f64.ScalInc(beta, y, uintptr(n), uintptr(incY))
-				} else {
-					f64.ScalInc(beta, y, uintptr(n), uintptr(-incY))
-				}
-			}
-		}
-	}
+	// Form y = beta * y.
+	dscalePrepY(n, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
+	impl.Counter.add(n * n)
+
 	if n == 1 {
 		y[0] += alpha * a[0] * x[0]
 		return
 	}
 
 	if ul == blas.Upper {
+		if impl.useUnitarySymv(n, incX, incY) {
+			// The off-diagonal part of row i is spread into y and dotted
+			// with x by the Level 1 kernels.
+			for i := 0; i < n; i++ {
+				atmp := a[i*lda+i+1 : i*lda+n]
+				f64.AxpyUnitary(alpha*x[i], atmp, y[i+1:n])
+				sum := x[i]*a[i*lda+i] + f64.DotUnitary(atmp, x[i+1:n])
+				y[i] += alpha * sum
+			}
+			return
+		}
 		if incX == 1 {
 			iy := ky
 			for i := 0; i < n; i++ {
@@ -683,6 +664,15 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 		return
 	}
 	// Cases where a is lower triangular.
+	if impl.useUnitarySymv(n, incX, incY) {
+		for i := 0; i < n; i++ {
+			atmp := a[i*lda : i*lda+i]
+			f64.AxpyUnitary(alpha*x[i], atmp, y[:i])
+			sum := f64.DotUnitary(atmp, x[:i]) + x[i]*a[i*lda+i]
+			y[i] += alpha * sum
+		}
+		return
+	}
 	if incX == 1 {
 		iy := ky
 		for i := 0; i < n; i++ {
@@ -756,6 +746,10 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularBandMaxIndex(ul, n, k, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
 		panic(shortA)
@@ -937,7 +931,10 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //  x = A * x   if tA == blas.NoTrans
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular matrix in packed format, and x is a vector.
-func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
+//
+// If the StrictPacked field of the receiver is true and n > 0, Dtpmv panics
+// unless len(ap) is exactly n*(n+1)/2.
+func (impl Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -959,10 +956,17 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(ap), packedMaxIndex(n), shortAP)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
 		panic(shortAP)
 	}
+	if impl.StrictPacked && len(ap) != n*(n+1)/2 {
+		panic(badLenAP)
+	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
 		panic(shortX)
 	}
@@ -978,11 +982,11 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		if ul == blas.Upper {
 			if incX == 1 {
 				for i := 0; i < n; i++ {
+					atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 					xi := x[i]
 					if nonUnit {
-						xi *= ap[offset]
+						xi *= aii
 					}
-					atmp := ap[offset+1 : offset+n-i]
 					xtmp := x[i+1:]
 					for j, v := range atmp {
 						xi += v * xtmp[j]
@@ -994,11 +998,11 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			}
 			ix := kx
 			for i := 0; i < n; i++ {
+				atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 				xix := x[ix]
 				if nonUnit {
-					xix *= ap[offset]
+					xix *= aii
 				}
-				atmp := ap[offset+1 : offset+n-i]
 				jx := kx + (i+1)*incX
 				for _, v := range atmp {
 					xix += v * x[jx]
@@ -1013,11 +1017,11 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		if incX == 1 {
 			offset = n*(n+1)/2 - 1
 			for i := n - 1; i >= 0; i-- {
+				atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 				xi := x[i]
 				if nonUnit {
-					xi *= ap[offset]
+					xi *= aii
 				}
-				atmp := ap[offset-i : offset]
 				for j, v := range atmp {
 					xi += v * x[j]
 				}
@@ -1029,11 +1033,11 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		ix := kx + (n-1)*incX
 		offset = n*(n+1)/2 - 1
 		for i := n - 1; i >= 0; i-- {
+			atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 			xix := x[ix]
 			if nonUnit {
-				xix *= ap[offset]
+				xix *= aii
 			}
-			atmp := ap[offset-i : offset]
 			jx := kx
 			for _, v := range atmp {
 				xix += v * x[jx]
@@ -1050,14 +1054,14 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		if incX == 1 {
 			offset = n*(n+1)/2 - 1
 			for i := n - 1; i >= 0; i-- {
+				atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 				xi := x[i]
-				atmp := ap[offset+1 : offset+n-i]
 				xtmp := x[i+1:]
 				for j, v := range atmp {
 					xtmp[j] += v * xi
 				}
 				if nonUnit {
-					x[i] *= ap[offset]
+					x[i] *= aii
 				}
 				offset -= n - i + 1
 			}
@@ -1066,15 +1070,15 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		ix := kx + (n-1)*incX
 		offset = n*(n+1)/2 - 1
 		for i := n - 1; i >= 0; i-- {
+			atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 			xix := x[ix]
 			jx := kx + (i+1)*incX
-			atmp := ap[offset+1 : offset+n-i]
 			for _, v := range atmp {
 				x[jx] += v * xix
 				jx += incX
 			}
 			if nonUnit {
-				x[ix] *= ap[offset]
+				x[ix] *= aii
 			}
 			offset -= n - i + 1
 			ix -= incX
@@ -1083,13 +1087,13 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	}
 	if incX == 1 {
 		for i := 0; i < n; i++ {
+			atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 			xi := x[i]
-			atmp := ap[offset-i : offset]
 			for j, v := range atmp {
 				x[j] += v * xi
 			}
 			if nonUnit {
-				x[i] *= ap[offset]
+				x[i] *= aii
 			}
 			offset += i + 2
 		}
@@ -1097,15 +1101,15 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	}
 	ix := kx
 	for i := 0; i < n; i++ {
+		atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 		xix := x[ix]
 		jx := kx
-		atmp := ap[offset-i : offset]
 		for _, v := range atmp {
 			x[jx] += v * xix
 			jx += incX
 		}
 		if nonUnit {
-			x[ix] *= ap[offset]
+			x[ix] *= aii
 		}
 		ix += incX
 		offset += i + 2
@@ -1151,6 +1155,10 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 		return
 	}
 
+	if checkBounds {
+		preflight(len(a), triangularBandMaxIndex(ul, n, k, lda), shortA)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
 		panic(shortA)
@@ -1338,7 +1346,7 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //  y = alpha * A * x + beta * y
 // where A is an n×n symmetric band matrix with k super-diagonals, x and y are
 // vectors, and alpha and beta are scalars.
-func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+func (impl Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1391,36 +1399,17 @@ func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64,
 	}
 
 	// Form y = beta * y.
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:n] {
-					y[i] = 0
-				}
-			} else {
-				f64.ScalUnitary(beta, y[:n])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < n; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f64.ScalInc(beta, y, uintptr(n), uintptr(incY))
-				} else {
-					f64.ScalInc(beta, y, uintptr(n), uintptr(-incY))
-				}
-			}
-		}
-	}
+	dscalePrepY(n, beta, y, incY)
 
 	if alpha == 0 {
 		return
 	}
 
+	if impl.Counter != nil {
+		// bandElements is O(m), so avoid it when not counting.
+		impl.Counter.add(bandElements(n, n, min(k, n-1), min(k, n-1)))
+	}
+
 	if ul == blas.Upper {
 		if incX == 1 {
 			iy := ky
@@ -1507,7 +1496,11 @@ func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64,
 // Dsyr performs the symmetric rank-one update
 //  A += alpha * x * xᵀ
 // where A is an n×n symmetric matrix, and x is a vector.
-func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) {
+//
+// If the SkipNonFinite field of the receiver is not nil, the elements of x
+// that are NaN or infinite are treated as zero, so that the corresponding rows
+// and columns of A are left unchanged, and they are counted in SkipNonFinite.
+func (impl Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1539,6 +1532,29 @@ func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 		return
 	}
 
+	if impl.SkipNonFinite != nil {
+		// Copy x with its NaN and infinite elements set to zero, so that
+		// the corresponding rows and columns of A are left unchanged.
+		// x[ix]-x[ix] is zero unless x[ix] is NaN or infinite.
+		var skipped int
+		tmp := make([]float64, n)
+		ix := 0
+		if incX < 0 {
+			ix = -(n - 1) * incX
+		}
+		for i := range tmp {
+			if x[ix]-x[ix] == 0 {
+				tmp[i] = x[ix]
+			} else {
+				skipped++
+			}
+			ix += incX
+		}
+		impl.SkipNonFinite.add(skipped)
+		x = tmp
+		incX = 1
+	}
+
 	lenX := n
 	var kx int
 	if incX < 0 {
@@ -1605,6 +1621,12 @@ func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 // Dsyr2 performs the symmetric rank-two update
 //  A += alpha * x * yᵀ + alpha * y * xᵀ
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
+//
+// Each referenced element A[i][j] is updated as
+//  A[i][j] += alpha * (x[i]*y[j] + x[j]*y[i])
+// with every product and sum rounded to float64, so the two cross terms are
+// always added in this order and the result does not depend on whether the
+// platform fuses multiply-add operations.
 func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
@@ -1657,7 +1679,7 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 				yi := y[i]
 				atmp := a[i*lda:]
 				for j := i; j < n; j++ {
-					atmp[j] += alpha * (xi*y[j] + x[j]*yi)
+					atmp[j] += float64(alpha * (float64(xi*y[j]) + float64(x[j]*yi)))
 				}
 			}
 			return
@@ -1671,7 +1693,7 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 			yi := y[iy]
 			atmp := a[i*lda:]
 			for j := i; j < n; j++ {
-				atmp[j] += alpha * (xi*y[jy] + x[jx]*yi)
+				atmp[j] += float64(alpha * (float64(xi*y[jy]) + float64(x[jx]*yi)))
 				jx += incX
 				jy += incY
 			}
@@ -1686,7 +1708,7 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 			yi := y[i]
 			atmp := a[i*lda:]
 			for j := 0; j <= i; j++ {
-				atmp[j] += alpha * (xi*y[j] + x[j]*yi)
+				atmp[j] += float64(alpha * (float64(xi*y[j]) + float64(x[j]*yi)))
 			}
 		}
 		return
@@ -1700,7 +1722,7 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 		yi := y[iy]
 		atmp := a[i*lda:]
 		for j := 0; j <= i; j++ {
-			atmp[j] += alpha * (xi*y[jy] + x[jx]*yi)
+			atmp[j] += float64(alpha * (float64(xi*y[jy]) + float64(x[jx]*yi)))
 			jx += incX
 			jy += incY
 		}
@@ -1719,7 +1741,10 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 //
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
-func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
+//
+// If the StrictPacked field of the receiver is true and n > 0, Dtpsv panics
+// unless len(ap) is exactly n*(n+1)/2.
+func (impl Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1741,10 +1766,17 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		return
 	}
 
+	if checkBounds {
+		preflight(len(ap), packedMaxIndex(n), shortAP)
+	}
+
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
 		panic(shortAP)
 	}
+	if impl.StrictPacked && len(ap) != n*(n+1)/2 {
+		panic(badLenAP)
+	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
 		panic(shortX)
 	}
@@ -1761,7 +1793,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			offset = n*(n+1)/2 - 1
 			if incX == 1 {
 				for i := n - 1; i >= 0; i-- {
-					atmp := ap[offset+1 : offset+n-i]
+					atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 					xtmp := x[i+1:]
 					var sum float64
 					for j, v := range atmp {
@@ -1769,7 +1801,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 					}
 					x[i] -= sum
 					if nonUnit {
-						x[i] /= ap[offset]
+						x[i] /= aii
 					}
 					offset -= n - i + 1
 				}
@@ -1777,7 +1809,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			}
 			ix := kx + (n-1)*incX
 			for i := n - 1; i >= 0; i-- {
-				atmp := ap[offset+1 : offset+n-i]
+				atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 				jx := kx + (i+1)*incX
 				var sum float64
 				for _, v := range atmp {
@@ -1786,7 +1818,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 				}
 				x[ix] -= sum
 				if nonUnit {
-					x[ix] /= ap[offset]
+					x[ix] /= aii
 				}
 				ix -= incX
 				offset -= n - i + 1
@@ -1795,14 +1827,14 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		}
 		if incX == 1 {
 			for i := 0; i < n; i++ {
-				atmp := ap[offset-i : offset]
+				atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 				var sum float64
 				for j, v := range atmp {
 					sum += v * x[j]
 				}
 				x[i] -= sum
 				if nonUnit {
-					x[i] /= ap[offset]
+					x[i] /= aii
 				}
 				offset += i + 2
 			}
@@ -1810,8 +1842,8 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		}
 		ix := kx
 		for i := 0; i < n; i++ {
+			atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 			jx := kx
-			atmp := ap[offset-i : offset]
 			var sum float64
 			for _, v := range atmp {
 				sum += v * x[jx]
@@ -1819,7 +1851,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 			}
 			x[ix] -= sum
 			if nonUnit {
-				x[ix] /= ap[offset]
+				x[ix] /= aii
 			}
 			ix += incX
 			offset += i + 2
@@ -1830,11 +1862,11 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if ul == blas.Upper {
 		if incX == 1 {
 			for i := 0; i < n; i++ {
+				atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 				if nonUnit {
-					x[i] /= ap[offset]
+					x[i] /= aii
 				}
 				xi := x[i]
-				atmp := ap[offset+1 : offset+n-i]
 				xtmp := x[i+1:]
 				for j, v := range atmp {
 					xtmp[j] -= v * xi
@@ -1845,11 +1877,11 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 		}
 		ix := kx
 		for i := 0; i < n; i++ {
+			atmp, aii := dpackedRow(ap, i, offset, offset+1, offset+n-i)
 			if nonUnit {
-				x[ix] /= ap[offset]
+				x[ix] /= aii
 			}
 			xix := x[ix]
-			atmp := ap[offset+1 : offset+n-i]
 			jx := kx + (i+1)*incX
 			for _, v := range atmp {
 				x[jx] -= v * xix
@@ -1863,11 +1895,11 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if incX == 1 {
 		offset = n*(n+1)/2 - 1
 		for i := n - 1; i >= 0; i-- {
+			atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 			if nonUnit {
-				x[i] /= ap[offset]
+				x[i] /= aii
 			}
 			xi := x[i]
-			atmp := ap[offset-i : offset]
 			for j, v := range atmp {
 				x[j] -= v * xi
 			}
@@ -1878,11 +1910,11 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	ix := kx + (n-1)*incX
 	offset = n*(n+1)/2 - 1
 	for i := n - 1; i >= 0; i-- {
+		atmp, aii := dpackedRow(ap, i, offset, offset-i, offset)
 		if nonUnit {
-			x[ix] /= ap[offset]
+			x[ix] /= aii
 		}
 		xix := x[ix]
-		atmp := ap[offset-i : offset]
 		jx := kx
 		for _, v := range atmp {
 			x[jx] -= v * xix
@@ -1897,7 +1929,11 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //  y = alpha * A * x + beta * y
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha and beta are scalars.
-func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap []float64, x []float64, incX int, beta float64, y []float64, incY int) {
+//
+// When incX and incY are both 1 and n is at least the SymvUnitary threshold,
+// the off-diagonal work is done by the Level 1 Axpy and Dot kernels, so the
+// result may differ in the last bits from that of the strided code.
+func (impl Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap []float64, x []float64, incX int, beta float64, y []float64, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -1942,31 +1978,7 @@ func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap []float64, x
 	}
 
 	// Form y = beta * y.
-	if beta != 1 {
-		if incY == 1 {
-			if beta == 0 {
-				for i := range y[:n] {
-					y[i] = 0
-				}
-			} else {
-				f64.ScalUnitary(beta, y[:n])
-			}
-		} else {
-			iy := ky
-			if beta == 0 {
-				for i := 0; i < n; i++ {
-					y[iy] = 0
-					iy += incY
-				}
-			} else {
-				if incY > 0 {
-					f64.ScalInc(beta, y, uintptr(n), uintptr(incY))
-				} else {
-					f64.ScalInc(beta, y, uintptr(n), uintptr(-incY))
-				}
-			}
-		}
-	}
+	dscalePrepY(n, beta, y, incY)
 
 	if alpha == 0 {
 		return
@@ -1978,6 +1990,18 @@ func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap []float64, x
 	}
 	var offset int // Offset is the index of (i,i).
 	if ul == blas.Upper {
+		if impl.useUnitarySymv(n, incX, incY) {
+			// The off-diagonal part of row i is spread into y and dotted
+			// with x by the Level 1 kernels.
+			for i := 0; i < n; i++ {
+				atmp := ap[offset+1 : offset+n-i]
+				f64.AxpyUnitary(alpha*x[i], atmp, y[i+1:n])
+				sum := ap[offset]*x[i] + f64.DotUnitary(atmp, x[i+1:n])
+				y[i] += alpha * sum
+				offset += n - i
+			}
+			return
+		}
 		if incX == 1 {
 			iy := ky
 			for i := 0; i < n; i++ {
@@ -2018,6 +2042,16 @@ func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap []float64, x
 		}
 		return
 	}
+	if impl.useUnitarySymv(n, incX, incY) {
+		for i := 0; i < n; i++ {
+			atmp := ap[offset-i : offset]
+			f64.AxpyUnitary(alpha*x[i], atmp, y[:i])
+			sum := f64.DotUnitary(atmp, x[:i]) + ap[offset]*x[i]
+			y[i] += alpha * sum
+			offset += i + 2
+		}
+		return
+	}
 	if incX == 1 {
 		iy := ky
 		for i := 0; i < n; i++ {
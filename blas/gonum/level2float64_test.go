@@ -22,6 +22,10 @@ func TestDtxmv(t *testing.T) {
 	testblas.DtxmvTest(t, impl)
 }
 
+func TestDtxmvAgreement(t *testing.T) {
+	testblas.DtxmvAgreementTest(t, impl)
+}
+
 func TestDgbmv(t *testing.T) {
 	testblas.DgbmvTest(t, impl)
 }
@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// Dnrm2Rows stores the Euclidean norm of each row of the m×n dense matrix A
+// into out.
+//  out[i] = ||A[i][:]||_2
+// The rows of A are contiguous and are processed with the unit increment
+// kernel of Dnrm2, so the result is the same as calling Dnrm2 on each row and
+// avoids overflow and underflow in the same way.
+func (Implementation) Dnrm2Rows(m, n int, a []float64, lda int, out []float64) {
+	checkMatNorm(m, n, a, lda, out, m)
+	if n == 0 {
+		zeroOut(out[:m])
+		return
+	}
+	for i := 0; i < m; i++ {
+		out[i] = f64.L2NormUnitary(a[i*lda : i*lda+n])
+	}
+}
+
+// Dnrm2Cols stores the Euclidean norm of each column of the m×n dense matrix
+// A into out.
+//  out[j] = ||A[:][j]||_2
+// The result is the same as calling Dnrm2 on each column with increment lda
+// and avoids overflow and underflow in the same way.
+func (Implementation) Dnrm2Cols(m, n int, a []float64, lda int, out []float64) {
+	checkMatNorm(m, n, a, lda, out, n)
+	if m == 0 {
+		zeroOut(out[:n])
+		return
+	}
+	for j := 0; j < n; j++ {
+		out[j] = f64.L2NormInc(a[j:], uintptr(m), uintptr(lda))
+	}
+}
+
+// DasumRows stores the sum of the absolute values of the elements of each row
+// of the m×n dense matrix A into out.
+//  out[i] = \sum_j |A[i][j]|
+// The result is the same as calling Dasum on each row.
+func (Implementation) DasumRows(m, n int, a []float64, lda int, out []float64) {
+	checkMatNorm(m, n, a, lda, out, m)
+	for i := 0; i < m; i++ {
+		var sum float64
+		for _, v := range a[i*lda : i*lda+n] {
+			sum += math.Abs(v)
+		}
+		out[i] = sum
+	}
+}
+
+// DasumCols stores the sum of the absolute values of the elements of each
+// column of the m×n dense matrix A into out.
+//  out[j] = \sum_i |A[i][j]|
+// A is traversed row by row, and each column sum is accumulated in the same
+// order as by Dasum, so the result is the same as calling Dasum on each
+// column with increment lda.
+func (Implementation) DasumCols(m, n int, a []float64, lda int, out []float64) {
+	checkMatNorm(m, n, a, lda, out, n)
+	out = out[:n]
+	zeroOut(out)
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			out[j] += math.Abs(v)
+		}
+	}
+}
+
+// checkMatNorm checks the arguments of the matrix row and column norm
+// routines. lenOut is the number of norms written to out.
+func checkMatNorm(m, n int, a []float64, lda int, out []float64, lenOut int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if len(out) < lenOut {
+		panic(shortOut)
+	}
+	if m > 0 && n > 0 && len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+}
+
+func zeroOut(x []float64) {
+	for i := range x {
+		x[i] = 0
+	}
+}
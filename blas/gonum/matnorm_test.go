@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestMatNorms(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {1, 5}, {5, 1}, {4, 4}, {7, 3}, {3, 9}} {
+		m, n := mn[0], mn[1]
+		for _, lda := range []int{max(1, n), n + 3} {
+			for _, scale := range []float64{1, 1e300, 1e-300} {
+				name := fmt.Sprintf("m=%v,n=%v,lda=%v,scale=%v", m, n, lda, scale)
+				a := make([]float64, max(0, lda*(m-1)+n))
+				for i := range a {
+					a[i] = math.NaN()
+				}
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						a[i*lda+j] = scale * rnd.NormFloat64()
+					}
+				}
+
+				for _, test := range []struct {
+					name    string
+					rows    bool
+					fn      func(m, n int, a []float64, lda int, out []float64)
+					perVecF func(n int, x []float64, incX int) float64
+				}{
+					{"Dnrm2Rows", true, impl.Dnrm2Rows, impl.Dnrm2},
+					{"Dnrm2Cols", false, impl.Dnrm2Cols, impl.Dnrm2},
+					{"DasumRows", true, impl.DasumRows, impl.Dasum},
+					{"DasumCols", false, impl.DasumCols, impl.Dasum},
+				} {
+					lenOut := n
+					if test.rows {
+						lenOut = m
+					}
+					out := make([]float64, lenOut+1)
+					for i := range out {
+						out[i] = math.NaN()
+					}
+					test.fn(m, n, a, lda, out)
+
+					for k := 0; k < lenOut; k++ {
+						var want float64
+						switch {
+						case test.rows && n > 0:
+							want = test.perVecF(n, a[k*lda:], 1)
+						case !test.rows && m > 0:
+							want = test.perVecF(m, a[k:], lda)
+						}
+						if out[k] != want {
+							t.Errorf("%v,%v: unexpected value at %v: want %v, got %v", name, test.name, k, want, out[k])
+						}
+						if math.IsInf(out[k], 0) {
+							t.Errorf("%v,%v: unexpected overflow at %v", name, test.name, k)
+						}
+					}
+					if !math.IsNaN(out[lenOut]) {
+						t.Errorf("%v,%v: unexpected modification of out beyond its length", name, test.name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMatNormsPanics(t *testing.T) {
+	a := make([]float64, 6)
+	out := make([]float64, 3)
+	for _, test := range []struct {
+		name string
+		fn   func(m, n int, a []float64, lda int, out []float64)
+	}{
+		{"Dnrm2Rows", impl.Dnrm2Rows},
+		{"Dnrm2Cols", impl.Dnrm2Cols},
+		{"DasumRows", impl.DasumRows},
+		{"DasumCols", impl.DasumCols},
+	} {
+		fn := test.fn
+		if !panics(func() { fn(-1, 3, a, 3, out) }) {
+			t.Errorf("%v: expected panic for m < 0", test.name)
+		}
+		if !panics(func() { fn(2, 3, a, 2, out) }) {
+			t.Errorf("%v: expected panic for bad lda", test.name)
+		}
+		if !panics(func() { fn(2, 3, a[:5], 3, out) }) {
+			t.Errorf("%v: expected panic for short a", test.name)
+		}
+		if !panics(func() { fn(2, 3, a, 3, out[:1]) }) {
+			t.Errorf("%v: expected panic for short out", test.name)
+		}
+	}
+}
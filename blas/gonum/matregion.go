@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/internal/asm/f64"
+
+// DscalMat scales the m×n dense matrix A by alpha.
+//  A *= alpha
+// If alpha is zero, A is set to zero without being read. When lda == n the
+// matrix is scaled as a single contiguous vector. The padding elements of A
+// are not referenced.
+func (Implementation) DscalMat(m, n int, alpha float64, a []float64, lda int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+
+	// Quick return if possible.
+	if alpha == 1 {
+		return
+	}
+
+	if lda == n {
+		// The rows are contiguous.
+		n *= m
+		m = 1
+	}
+	for i := 0; i < m; i++ {
+		aRow := a[i*lda : i*lda+n]
+		if alpha == 0 {
+			for j := range aRow {
+				aRow[j] = 0
+			}
+			continue
+		}
+		f64.ScalUnitary(alpha, aRow)
+	}
+}
+
+// DaxpyMat adds alpha times the m×n dense matrix X to the m×n dense matrix
+// Y.
+//  Y += alpha * X
+// When ldx == ldy == n the matrices are processed as single contiguous
+// vectors. The padding elements of X and Y are not referenced.
+func (Implementation) DaxpyMat(m, n int, alpha float64, x []float64, ldx int, y []float64, ldy int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if ldx < max(1, n) {
+		panic(badLdX)
+	}
+	if ldy < max(1, n) {
+		panic(badLdY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) < ldx*(m-1)+n {
+		panic(shortX)
+	}
+	if len(y) < ldy*(m-1)+n {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 {
+		return
+	}
+
+	if ldx == n && ldy == n {
+		// The rows are contiguous.
+		n *= m
+		m = 1
+	}
+	for i := 0; i < m; i++ {
+		f64.AxpyUnitary(alpha, x[i*ldx:i*ldx+n], y[i*ldy:i*ldy+n])
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDscalMat(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 3, 6} {
+		for _, n := range []int{0, 1, 4, 7} {
+			for _, pad := range []int{0, 1, 5} {
+				for _, alpha := range []float64{0, 1, -2.5} {
+					lda := max(1, n) + pad
+					name := fmt.Sprintf("m=%v,n=%v,lda=%v,alpha=%v", m, n, lda, alpha)
+					a := randvec(m*lda, 1, rnd)
+					want := make([]float64, len(a))
+					copy(want, a)
+					for i := 0; i < m; i++ {
+						for j := 0; j < n; j++ {
+							want[i*lda+j] *= alpha
+						}
+					}
+					if alpha == 0 {
+						// Zero alpha sets A to zero even where
+						// it holds NaN.
+						for i := 0; i < m; i++ {
+							for j := 0; j < n; j++ {
+								a[i*lda+j] = math.NaN()
+								want[i*lda+j] = 0
+							}
+						}
+					}
+					impl.DscalMat(m, n, alpha, a, lda)
+					for i := range want {
+						if a[i] != want[i] {
+							t.Errorf("%v: unexpected element %v: got %v, want %v", name, i, a[i], want[i])
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDaxpyMat(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 3, 6} {
+		for _, n := range []int{0, 1, 4, 7} {
+			for _, pad := range [][2]int{{0, 0}, {0, 2}, {3, 0}, {1, 4}} {
+				for _, alpha := range []float64{0, 1, -2.5} {
+					ldx := max(1, n) + pad[0]
+					ldy := max(1, n) + pad[1]
+					name := fmt.Sprintf("m=%v,n=%v,ldx=%v,ldy=%v,alpha=%v", m, n, ldx, ldy, alpha)
+					x := randvec(m*ldx, 1, rnd)
+					y := randvec(m*ldy, 1, rnd)
+					// Padding elements of x must not be referenced.
+					for i := 0; i < m; i++ {
+						for j := n; j < ldx; j++ {
+							x[i*ldx+j] = math.NaN()
+						}
+					}
+					want := make([]float64, len(y))
+					copy(want, y)
+					for i := 0; i < m; i++ {
+						for j := 0; j < n; j++ {
+							want[i*ldy+j] += alpha * x[i*ldx+j]
+						}
+					}
+					impl.DaxpyMat(m, n, alpha, x, ldx, y, ldy)
+					for i := range want {
+						if math.IsNaN(y[i]) || math.Abs(y[i]-want[i]) > 1e-15*math.Max(1, math.Abs(want[i])) {
+							t.Errorf("%v: unexpected element %v: got %v, want %v", name, i, y[i], want[i])
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The naive reference routines below compute the Level 2 operations directly
+// from their definitions, element by element, without the quick returns and
+// stride special cases of the production code, so that they can serve as an
+// independent check of it. Only two cases defined by the reference BLAS are
+// special: y is not referenced when beta == 0, and it is left unchanged by
+// naiveGemv when A is empty.
+
+// naiveGemv computes
+//  y = alpha * A * x + beta * y   if tA == blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  otherwise
+// where A is an m×n dense matrix.
+func naiveGemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	if m == 0 || n == 0 {
+		return
+	}
+	rows, cols := m, n
+	if tA != blas.NoTrans {
+		rows, cols = n, m
+	}
+	at := func(i, j int) float64 {
+		if tA != blas.NoTrans {
+			i, j = j, i
+		}
+		return a[i*lda+j]
+	}
+	for i := 0; i < rows; i++ {
+		var sum float64
+		for j := 0; j < cols; j++ {
+			sum += at(i, j) * x[vecIndex(j, cols, incX)]
+		}
+		iy := vecIndex(i, rows, incY)
+		if beta == 0 {
+			y[iy] = alpha * sum
+		} else {
+			y[iy] = alpha*sum + beta*y[iy]
+		}
+	}
+}
+
+// naiveGer computes
+//  A += alpha * x * yᵀ
+// where A is an m×n dense matrix.
+func naiveGer(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] += alpha * x[vecIndex(i, m, incX)] * y[vecIndex(j, n, incY)]
+		}
+	}
+}
+
+// naiveSymv computes
+//  y = alpha * A * x + beta * y
+// where A is an n×n symmetric matrix of which only the ul triangle is read.
+func naiveSymv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	at := func(i, j int) float64 {
+		if (ul == blas.Upper && i > j) || (ul == blas.Lower && i < j) {
+			i, j = j, i
+		}
+		return a[i*lda+j]
+	}
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += at(i, j) * x[vecIndex(j, n, incX)]
+		}
+		iy := vecIndex(i, n, incY)
+		if beta == 0 {
+			y[iy] = alpha * sum
+		} else {
+			y[iy] = alpha*sum + beta*y[iy]
+		}
+	}
+}
+
+// naiveTol returns the tolerance for comparing an element of a product with
+// k terms of magnitude at most mag against its naive reference.
+func naiveTol(k int, mag float64) float64 {
+	return float64(4*(k+2)) * 0x1p-52 * math.Max(1, mag)
+}
+
+func randNaiveParams(rnd *rand.Rand) (m, n, lda, incX, incY int, alpha, beta float64) {
+	m = rnd.Intn(12)
+	n = rnd.Intn(12)
+	lda = max(1, n) + rnd.Intn(3)
+	incs := []int{1, 1, 2, 3, -1, -2}
+	incX = incs[rnd.Intn(len(incs))]
+	incY = incs[rnd.Intn(len(incs))]
+	scalars := []float64{0, 1, -1, 0.5, rnd.NormFloat64()}
+	alpha = scalars[rnd.Intn(len(scalars))]
+	beta = scalars[rnd.Intn(len(scalars))]
+	return m, n, lda, incX, incY, alpha, beta
+}
+
+func TestDgemvNaive(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 2000; trial++ {
+		m, n, lda, incX, incY, alpha, beta := randNaiveParams(rnd)
+		tA := []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans}[rnd.Intn(3)]
+		lenX, lenY := n, m
+		if tA != blas.NoTrans {
+			lenX, lenY = m, n
+		}
+		a := randvec(m*lda, 1, rnd)
+		x := randvec(lenX, incX, rnd)
+		y := randvec(lenY, incY, rnd)
+
+		want := make([]float64, len(y))
+		copy(want, y)
+		naiveGemv(tA, m, n, alpha, a, lda, x, incX, beta, want, incY)
+		got := make([]float64, len(y))
+		copy(got, y)
+		impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, got, incY)
+
+		name := fmt.Sprintf("tA=%c,m=%v,n=%v,lda=%v,incX=%v,incY=%v,alpha=%v,beta=%v", tA, m, n, lda, incX, incY, alpha, beta)
+		checkNaive(t, name, got, want, lenX, 4)
+	}
+}
+
+func TestDgerNaive(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 2000; trial++ {
+		m, n, lda, incX, incY, alpha, _ := randNaiveParams(rnd)
+		a := randvec(m*lda, 1, rnd)
+		x := randvec(m, incX, rnd)
+		y := randvec(n, incY, rnd)
+
+		want := make([]float64, len(a))
+		copy(want, a)
+		naiveGer(m, n, alpha, x, incX, y, incY, want, lda)
+		got := make([]float64, len(a))
+		copy(got, a)
+		impl.Dger(m, n, alpha, x, incX, y, incY, got, lda)
+
+		name := fmt.Sprintf("m=%v,n=%v,lda=%v,incX=%v,incY=%v,alpha=%v", m, n, lda, incX, incY, alpha)
+		checkNaive(t, name, got, want, 1, 4)
+	}
+}
+
+func TestDsymvNaive(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 2000; trial++ {
+		_, n, lda, incX, incY, alpha, beta := randNaiveParams(rnd)
+		ul := []blas.Uplo{blas.Upper, blas.Lower}[rnd.Intn(2)]
+		a := randvec(n*lda, 1, rnd)
+		// The triangle opposite ul must not be referenced.
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if (ul == blas.Upper && i > j) || (ul == blas.Lower && i < j) {
+					a[i*lda+j] = math.NaN()
+				}
+			}
+		}
+		x := randvec(n, incX, rnd)
+		y := randvec(n, incY, rnd)
+
+		want := make([]float64, len(y))
+		copy(want, y)
+		naiveSymv(ul, n, alpha, a, lda, x, incX, beta, want, incY)
+		got := make([]float64, len(y))
+		copy(got, y)
+		impl.Dsymv(ul, n, alpha, a, lda, x, incX, beta, got, incY)
+
+		name := fmt.Sprintf("ul=%c,n=%v,lda=%v,incX=%v,incY=%v,alpha=%v,beta=%v", ul, n, lda, incX, incY, alpha, beta)
+		checkNaive(t, name, got, want, n, 4)
+	}
+}
+
+// checkNaive compares the production result got against the naive
+// reference want element-wise, including the elements between vector or
+// matrix elements, which must be unchanged by both. The random elements are
+// normally distributed with magnitude below mag, and k is the number of
+// terms in each dot product.
+func checkNaive(t *testing.T, name string, got, want []float64, k int, mag float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%v: length mismatch: got %v, want %v", name, len(got), len(want))
+		return
+	}
+	tol := naiveTol(k, float64(k+1)*mag*mag)
+	for i := range want {
+		if math.IsNaN(got[i]) || math.Abs(got[i]-want[i]) > tol {
+			t.Errorf("%v: result differs from naive reference at %v: got %v, want %v", name, i, got[i], want[i])
+			return
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !bounds
+
+package gonum
+
+const checkBounds = false
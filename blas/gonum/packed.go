@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// DtpToDense copies the n×n triangular matrix A stored in packed format in ap
+// into the ul triangle of the dense matrix a with leading dimension lda.
+//
+// If d == blas.Unit the diagonal elements of A are not referenced in ap and
+// the diagonal of a is not modified. The elements of a outside the ul
+// triangle are not modified.
+func (Implementation) DtpToDense(ul blas.Uplo, d blas.Diag, n int, ap []float64, a []float64, lda int) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+
+	nonUnit := d == blas.NonUnit
+	var off int
+	if ul == blas.Upper {
+		for i := 0; i < n; i++ {
+			if nonUnit {
+				a[i*lda+i] = ap[off]
+			}
+			copy(a[i*lda+i+1:i*lda+n], ap[off+1:off+n-i])
+			off += n - i
+		}
+		return
+	}
+	for i := 0; i < n; i++ {
+		copy(a[i*lda:i*lda+i], ap[off:off+i])
+		if nonUnit {
+			a[i*lda+i] = ap[off+i]
+		}
+		off += i + 1
+	}
+}
+
+// DenseToTp copies the ul triangle of the n×n dense matrix a with leading
+// dimension lda, including the diagonal, into ap in packed format.
+func (Implementation) DenseToTp(ul blas.Uplo, n int, a []float64, lda int, ap []float64) {
+	if ul != blas.Lower && ul != blas.Upper {
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+
+	var off int
+	if ul == blas.Upper {
+		for i := 0; i < n; i++ {
+			off += copy(ap[off:], a[i*lda+i:i*lda+n])
+		}
+		return
+	}
+	for i := 0; i < n; i++ {
+		off += copy(ap[off:], a[i*lda:i*lda+i+1])
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDtpToDenseRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+			for _, n := range []int{0, 1, 2, 3, 5, 10} {
+				for _, lda := range []int{max(1, n), n + 3} {
+					prefix := fmt.Sprintf("ul=%v,d=%v,n=%v,lda=%v", ul, d, n, lda)
+
+					ap := randvec(n*(n+1)/2, 1, rnd)
+					// Fill a with NaN so that elements that are
+					// not written by DtpToDense are detected.
+					a := make([]float64, n*lda)
+					for i := range a {
+						a[i] = math.NaN()
+					}
+					impl.DtpToDense(ul, d, n, ap, a, lda)
+
+					for i := 0; i < n; i++ {
+						for j := 0; j < lda; j++ {
+							v := a[i*lda+j]
+							inTri := j < n && ((ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i))
+							if !inTri || (i == j && d == blas.Unit) {
+								if !math.IsNaN(v) {
+									t.Errorf("%v: element (%v,%v) outside the referenced triangle modified", prefix, i, j)
+								}
+								continue
+							}
+							var want float64
+							if ul == blas.Upper {
+								want = ap[i*n-i*(i-1)/2+j-i]
+							} else {
+								want = ap[i*(i+1)/2+j]
+							}
+							if v != want {
+								t.Errorf("%v: unexpected element (%v,%v): got %v, want %v", prefix, i, j, v, want)
+							}
+						}
+					}
+
+					// With a unit diagonal the diagonal of ap is
+					// not referenced, so set it in a to make the
+					// round trip the identity.
+					if d == blas.Unit {
+						for i := 0; i < n; i++ {
+							a[i*lda+i] = ap[packedDiagIndex(ul, n, i)]
+						}
+					}
+					got := make([]float64, len(ap))
+					impl.DenseToTp(ul, n, a, lda, got)
+					if !floats.Same(got, ap) {
+						t.Errorf("%v: pack→dense→pack is not the identity\nwant %v\ngot  %v", prefix, ap, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+// packedDiagIndex returns the index of the i-th diagonal element of an n×n
+// triangular matrix stored in packed format.
+func packedDiagIndex(ul blas.Uplo, n, i int) int {
+	if ul == blas.Upper {
+		return i*n - i*(i-1)/2
+	}
+	return i*(i+1)/2 + i
+}
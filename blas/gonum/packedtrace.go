@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// packedRowHook, if not nil, is called by Dtpmv and Dtpsv, and their single
+// precision counterparts, for each row i of the packed matrix before the
+// off-diagonal elements of the row are used. diag is the index in ap of the
+// diagonal element A[i][i], and lo and hi delimit the half-open range of ap
+// holding the off-diagonal elements of row i in the stored triangle.
+//
+// packedRowHook allows tests to check the packed offsets independently of the
+// numeric results. It is a mutable package-level variable, so tests that set
+// it must not call t.Parallel, and must restore it to nil when they finish.
+var packedRowHook func(i, diag, lo, hi int)
+
+// dpackedRow returns the off-diagonal elements ap[lo:hi] of row i of a packed
+// triangular matrix and its diagonal element ap[diag]. It reports the same
+// indices to packedRowHook, so the traced offsets are those the caller uses.
+func dpackedRow(ap []float64, i, diag, lo, hi int) (row []float64, aii float64) {
+	tracePackedRow(i, diag, lo, hi)
+	return ap[lo:hi], ap[diag]
+}
+
+// spackedRow is the single precision counterpart of dpackedRow.
+func spackedRow(ap []float32, i, diag, lo, hi int) (row []float32, aii float32) {
+	tracePackedRow(i, diag, lo, hi)
+	return ap[lo:hi], ap[diag]
+}
+
+// tracePackedRow calls packedRowHook if it is set.
+func tracePackedRow(i, diag, lo, hi int) {
+	if packedRowHook != nil {
+		packedRowHook(i, diag, lo, hi)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// TestPackedOffsets checks that the packed triangular routines locate the
+// diagonal and off-diagonal elements of every row at the offsets given by
+// the packed storage layout, independently of the computed values.
+func TestPackedOffsets(t *testing.T) {
+	defer func() { packedRowHook = nil }()
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 5, 8} {
+					for _, incX := range []int{1, -2} {
+						ap := randvec(n*(n+1)/2, 1, rnd)
+						for i := 0; i < n; i++ {
+							ap[packedDiagIndex(ul, n, i)] = 4 + rnd.Float64()
+						}
+						ap32 := make([]float32, len(ap))
+						for i, v := range ap {
+							ap32[i] = float32(v)
+						}
+						x := randvec(n, incX, rnd)
+						x32 := make([]float32, len(x))
+						for i, v := range x {
+							x32[i] = float32(v)
+						}
+						for _, routine := range []struct {
+							name string
+							call func()
+						}{
+							{"Dtpmv", func() { impl.Dtpmv(ul, tA, d, n, ap, append([]float64(nil), x...), incX) }},
+							{"Dtpsv", func() { impl.Dtpsv(ul, tA, d, n, ap, append([]float64(nil), x...), incX) }},
+							{"Stpmv", func() { impl.Stpmv(ul, tA, d, n, ap32, append([]float32(nil), x32...), incX) }},
+							{"Stpsv", func() { impl.Stpsv(ul, tA, d, n, ap32, append([]float32(nil), x32...), incX) }},
+						} {
+							name := fmt.Sprintf("%v,ul=%c,tA=%c,d=%c,n=%v,incX=%v", routine.name, ul, tA, d, n, incX)
+							visits := make([]int, n)
+							packedRowHook = func(i, diag, lo, hi int) {
+								if i < 0 || n <= i {
+									t.Errorf("%v: row %v out of range", name, i)
+									return
+								}
+								visits[i]++
+								wantDiag := packedDiagIndex(ul, n, i)
+								wantLo, wantHi := wantDiag+1, wantDiag+n-i
+								if ul == blas.Lower {
+									wantLo, wantHi = wantDiag-i, wantDiag
+								}
+								if diag != wantDiag || lo != wantLo || hi != wantHi {
+									t.Errorf("%v: unexpected offsets for row %v: got diag=%v,[%v,%v), want diag=%v,[%v,%v)",
+										name, i, diag, lo, hi, wantDiag, wantLo, wantHi)
+								}
+							}
+							routine.call()
+							packedRowHook = nil
+							for i, v := range visits {
+								if v != 1 {
+									t.Errorf("%v: row %v visited %v times, want 1", name, i, v)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
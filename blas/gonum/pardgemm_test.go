@@ -15,6 +15,7 @@ import (
 
 func TestDgemmParallel(t *testing.T) {
 	rnd := rand.New(rand.NewSource(1))
+	minParBlock := defaultThresholds.GemmParallel
 	for i, test := range []struct {
 		m     int
 		n     int
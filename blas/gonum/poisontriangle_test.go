@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// The tests below check that the triangular routines never read the
+// storage outside the referenced triangle, the unused corners and padding of
+// band storage, or the diagonal of a unit triangular matrix. These elements
+// are set to NaN, and the result must be finite and bitwise equal to the
+// result with the same elements set to zero.
+
+// unpoison returns a copy of a with NaN elements replaced by zero.
+func unpoison(a []float64) []float64 {
+	clean := make([]float64, len(a))
+	for i, v := range a {
+		if !math.IsNaN(v) {
+			clean[i] = v
+		}
+	}
+	return clean
+}
+
+// checkPoison calls f with the poisoned and the clean matrix on copies of x
+// and reports whether the results differ or are not finite.
+func checkPoison(t *testing.T, name string, poisoned, clean, x []float64, f func(a, x []float64)) {
+	t.Helper()
+	want := make([]float64, len(x))
+	copy(want, x)
+	f(clean, want)
+	got := make([]float64, len(x))
+	copy(got, x)
+	f(poisoned, got)
+	for _, v := range got {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Errorf("%s: poisoned element read, result not finite: %v", name, got)
+			return
+		}
+	}
+	if !floats.Same(got, want) {
+		t.Errorf("%s: result depends on poisoned elements: got %v, want %v", name, got, want)
+	}
+}
+
+func TestTriangularPoison(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 7} {
+					for _, incX := range []int{1, 2, -3} {
+						name := fmt.Sprintf("ul=%c,tA=%c,d=%c,n=%d,incX=%d", ul, tA, d, n, incX)
+						x := randvec(n, incX, rnd)
+
+						lda := n + 2
+						a := randomTriangular(rnd, ul, n, lda)
+						clean := unpoison(a)
+						if d == blas.Unit {
+							for i := 0; i < n; i++ {
+								a[i*lda+i] = math.NaN()
+							}
+						}
+						checkPoison(t, "Dtrmv,"+name, a, clean, x, func(a, x []float64) {
+							impl.Dtrmv(ul, tA, d, n, a, lda, x, incX)
+						})
+						checkPoison(t, "Dtrsv,"+name, a, clean, x, func(a, x []float64) {
+							impl.Dtrsv(ul, tA, d, n, a, lda, x, incX)
+						})
+
+						// Packed storage has no inactive triangle, so only
+						// the unit diagonal and elements past the end of ap
+						// are poisoned.
+						ap := make([]float64, 0, n*(n+1)/2+3)
+						for i := 0; i < n; i++ {
+							lo, hi := i, n
+							if ul == blas.Lower {
+								lo, hi = 0, i+1
+							}
+							ap = append(ap, a[i*lda+lo:i*lda+hi]...)
+						}
+						ap = append(ap, nanSlice(3)...)
+						checkPoison(t, "Dtpmv,"+name, ap, unpoison(ap), x, func(ap, x []float64) {
+							impl.Dtpmv(ul, tA, d, n, ap, x, incX)
+						})
+						checkPoison(t, "Dtpsv,"+name, ap, unpoison(ap), x, func(ap, x []float64) {
+							impl.Dtpsv(ul, tA, d, n, ap, x, incX)
+						})
+
+						for _, k := range []int{0, 1, 2, n + 1} {
+							name := fmt.Sprintf("%s,k=%d", name, k)
+							ldab := k + 2
+							ab := randomTriangularBand(rnd, ul, n, k, ldab)
+							clean := unpoison(ab)
+							if d == blas.Unit {
+								for i := 0; i < n; i++ {
+									if ul == blas.Upper {
+										ab[i*ldab] = math.NaN()
+									} else {
+										ab[i*ldab+k] = math.NaN()
+									}
+								}
+							}
+							checkPoison(t, "Dtbmv,"+name, ab, clean, x, func(ab, x []float64) {
+								impl.Dtbmv(ul, tA, d, n, k, ab, ldab, x, incX)
+							})
+							checkPoison(t, "Dtbsv,"+name, ab, clean, x, func(ab, x []float64) {
+								impl.Dtbsv(ul, tA, d, n, k, ab, ldab, x, incX)
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+}
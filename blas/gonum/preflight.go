@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The functions below return the largest index into the matrix slice that a
+// routine will access for the given matrix parameters, or -1 if no element
+// is accessed. When the package is built with the bounds tag, the routines
+// pass the result to preflight after their scalar argument checks and before
+// their own slice length checks, so that every matrix slice too short for the
+// kernel is caught by the same check regardless of which routine received it.
+// The slice length checks of the routines may require more elements than the
+// kernel accesses, for example the unused trailing elements of the last row
+// of a band matrix, and still apply to slices that pass preflight.
+
+// preflight panics if the element at index maxIdx is not within a slice of
+// length n. The panic message is msg followed by the index and the length, so
+// that it can be told apart from the message of a routine's own check.
+func preflight(n, maxIdx int, msg string) {
+	if maxIdx >= n {
+		panic(fmt.Sprintf("%s: index %d out of range for length %d", msg, maxIdx, n))
+	}
+}
+
+// generalMaxIndex returns the largest index accessed in an m×n general
+// matrix with leading dimension lda.
+func generalMaxIndex(m, n, lda int) int {
+	if m == 0 || n == 0 {
+		return -1
+	}
+	return (m-1)*lda + n - 1
+}
+
+// bandMaxIndex returns the largest index accessed in an m×n band matrix with
+// kL sub-diagonals and kU super-diagonals stored in compact form with leading
+// dimension lda.
+func bandMaxIndex(m, n, kL, kU, lda int) int {
+	if m == 0 || n == 0 {
+		return -1
+	}
+	i := min(m, n+kL) - 1
+	return i*lda + min(kL+kU, n+kL-i-1)
+}
+
+// triangularMaxIndex returns the largest index accessed in the stored
+// triangle of an n×n symmetric or triangular matrix with leading dimension
+// lda. The last row of both the upper and the lower triangle ends at the
+// diagonal, so the result does not depend on the triangle.
+func triangularMaxIndex(n, lda int) int {
+	return generalMaxIndex(n, n, lda)
+}
+
+// triangularBandMaxIndex returns the largest index accessed in the stored
+// triangle of an n×n triangular band matrix with k off-diagonals stored in
+// compact form with leading dimension lda.
+func triangularBandMaxIndex(ul blas.Uplo, n, k, lda int) int {
+	if n == 0 {
+		return -1
+	}
+	if ul == blas.Upper {
+		// The last row holds only the diagonal in its first column.
+		return (n - 1) * lda
+	}
+	return (n-1)*lda + k
+}
+
+// packedMaxIndex returns the largest index accessed in an n×n symmetric or
+// triangular matrix stored in packed form.
+func packedMaxIndex(n int) int {
+	return n*(n+1)/2 - 1
+}
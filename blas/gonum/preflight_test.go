@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestPreflight(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, mn := range [][2]int{{1, 1}, {1, 4}, {4, 1}, {3, 3}, {5, 7}, {7, 5}} {
+		m, n := mn[0], mn[1]
+		for _, ldaPad := range []int{0, 3} {
+			lda := n + ldaPad
+			name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+			x := randvec(max(m, n), 1, rnd)
+			testPreflight(t, rnd, "Dgemv/"+name, generalMaxIndex(m, n, lda), func(a []float64) []float64 {
+				y := make([]float64, m)
+				impl.Dgemv(blas.NoTrans, m, n, 1, a, lda, x, 1, 0, y, 1)
+				return y
+			})
+			testPreflight(t, rnd, "Dger/"+name, generalMaxIndex(m, n, lda), func(a []float64) []float64 {
+				impl.Dger(m, n, 1, x, 1, x, 1, a, lda)
+				return a[:generalMaxIndex(m, n, lda)+1]
+			})
+			for _, kL := range []int{0, 1, 3, m + 1} {
+				for _, kU := range []int{0, 2, n + 1} {
+					lda := kL + kU + 1 + ldaPad
+					name := fmt.Sprintf("m=%v,n=%v,kL=%v,kU=%v,lda=%v", m, n, kL, kU, lda)
+					testPreflight(t, rnd, "Dgbmv/"+name, bandMaxIndex(m, n, kL, kU, lda), func(a []float64) []float64 {
+						y := make([]float64, m)
+						impl.Dgbmv(blas.NoTrans, m, n, kL, kU, 1, a, lda, x, 1, 0, y, 1)
+						return y
+					})
+				}
+			}
+			if m != n {
+				continue
+			}
+			testPreflight(t, rnd, "Dsymv/"+name, triangularMaxIndex(n, lda), func(a []float64) []float64 {
+				y := make([]float64, n)
+				impl.Dsymv(blas.Upper, n, 1, a, lda, x, 1, 0, y, 1)
+				impl.Dsymv(blas.Lower, n, 1, a, lda, x, 1, 1, y, 1)
+				return y
+			})
+			for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+					name := fmt.Sprintf("ul=%v,d=%v,%v", ul, d, name)
+					testPreflight(t, rnd, "Dtrmv/"+name, triangularMaxIndex(n, lda), func(a []float64) []float64 {
+						y := make([]float64, n)
+						copy(y, x)
+						impl.Dtrmv(ul, blas.NoTrans, d, n, a, lda, y, 1)
+						return y
+					})
+					testPreflight(t, rnd, "Dtrsv/"+name, triangularMaxIndex(n, lda), func(a []float64) []float64 {
+						y := make([]float64, n)
+						copy(y, x)
+						impl.Dtrsv(ul, blas.Trans, d, n, a, lda, y, 1)
+						return y
+					})
+					testPreflight(t, rnd, "Dtpmv/"+name, packedMaxIndex(n), func(ap []float64) []float64 {
+						y := make([]float64, n)
+						copy(y, x)
+						impl.Dtpmv(ul, blas.NoTrans, d, n, ap, y, 1)
+						return y
+					})
+					testPreflight(t, rnd, "Dtpsv/"+name, packedMaxIndex(n), func(ap []float64) []float64 {
+						y := make([]float64, n)
+						copy(y, x)
+						impl.Dtpsv(ul, blas.Trans, d, n, ap, y, 1)
+						return y
+					})
+					for _, k := range []int{0, 1, n - 1, n + 1} {
+						lda := k + 1 + ldaPad
+						name := fmt.Sprintf("%v,k=%v,lda=%v", name, k, lda)
+						testPreflight(t, rnd, "Dtbmv/"+name, triangularBandMaxIndex(ul, n, k, lda), func(a []float64) []float64 {
+							y := make([]float64, n)
+							copy(y, x)
+							impl.Dtbmv(ul, blas.NoTrans, d, n, k, a, lda, y, 1)
+							return y
+						})
+						testPreflight(t, rnd, "Dtbsv/"+name, triangularBandMaxIndex(ul, n, k, lda), func(a []float64) []float64 {
+							y := make([]float64, n)
+							copy(y, x)
+							impl.Dtbsv(ul, blas.Trans, d, n, k, a, lda, y, 1)
+							return y
+						})
+					}
+				}
+			}
+		}
+	}
+}
+
+// testPreflight checks that preflight accepts a matrix slice that is just
+// long enough to hold the element at maxIdx and rejects a shorter one, and
+// that the routine run by f does not access any element beyond maxIdx of the
+// matrix slice it is given.
+func testPreflight(t *testing.T, rnd *rand.Rand, name string, maxIdx int, f func(a []float64) []float64) {
+	if panics(func() { preflight(maxIdx+1, maxIdx, shortA) }) {
+		t.Errorf("%v: unexpected panic for a slice of length %v", name, maxIdx+1)
+	}
+	if !panics(func() { preflight(maxIdx, maxIdx, shortA) }) {
+		t.Errorf("%v: no panic for a slice of length %v", name, maxIdx)
+	}
+
+	// Elements beyond maxIdx are set to NaN so that any access to them
+	// shows up in the result. The slice is over-sized so that it passes the
+	// routine's own length checks. The diagonal is kept away from zero so
+	// that triangular solves are well defined.
+	a := make([]float64, maxIdx+100)
+	for i := range a {
+		if i <= maxIdx {
+			a[i] = 1 + rnd.Float64()
+		} else {
+			a[i] = math.NaN()
+		}
+	}
+	for i, v := range f(a) {
+		if math.IsNaN(v) {
+			t.Errorf("%v: element beyond maxIdx=%v accessed, result[%v] is NaN", name, maxIdx, i)
+			break
+		}
+	}
+}
+
+// TestPreflightShort passes matrix slices that are too short to each routine
+// and checks which check reports them. A slice that does not hold the last
+// element accessed by the kernel is reported by preflight when the package
+// is built with the bounds tag and by the length check of the routine
+// otherwise. A slice that holds that element but is shorter than the length
+// the routine requires is reported by the routine in both cases.
+func TestPreflightShort(t *testing.T) {
+	const (
+		m, n   = 4, 3
+		kL, kU = 1, 2
+		k      = 2
+	)
+	x := make([]float64, m+n)
+	y := make([]float64, m+n)
+	x32 := make([]float32, m+n)
+	y32 := make([]float32, m+n)
+	for i := range x {
+		x[i] = 1
+		x32[i] = 1
+	}
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		lda := n + 1
+		ldb := kL + kU + 2
+		ldt := k + 2
+		for _, test := range []struct {
+			name   string
+			maxIdx int
+			reqLen int
+			msg    string
+			f      func(a []float64)
+		}{
+			{
+				name:   "Dgemv",
+				maxIdx: generalMaxIndex(m, n, lda),
+				reqLen: lda*(m-1) + n,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dgemv(blas.NoTrans, m, n, 1, a, lda, x, 1, 0, y, 1) },
+			},
+			{
+				name:   "Sgemv",
+				maxIdx: generalMaxIndex(m, n, lda),
+				reqLen: lda*(m-1) + n,
+				msg:    shortA,
+				f: func(a []float64) {
+					impl.Sgemv(blas.NoTrans, m, n, 1, make([]float32, len(a)), lda, x32, 1, 0, y32, 1)
+				},
+			},
+			{
+				name:   "Dger",
+				maxIdx: generalMaxIndex(m, n, lda),
+				reqLen: lda*(m-1) + n,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dger(m, n, 1, x, 1, y, 1, a, lda) },
+			},
+			{
+				name:   "Dgbmv",
+				maxIdx: bandMaxIndex(m, n, kL, kU, ldb),
+				reqLen: ldb*(min(m, n+kL)-1) + kL + kU + 1,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dgbmv(blas.NoTrans, m, n, kL, kU, 1, a, ldb, x, 1, 0, y, 1) },
+			},
+			{
+				name:   "Dsymv",
+				maxIdx: triangularMaxIndex(n, lda),
+				reqLen: lda*(n-1) + n,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dsymv(ul, n, 1, a, lda, x, 1, 0, y, 1) },
+			},
+			{
+				name:   "Dtrmv",
+				maxIdx: triangularMaxIndex(n, lda),
+				reqLen: lda*(n-1) + n,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dtrmv(ul, blas.NoTrans, blas.Unit, n, a, lda, x, 1) },
+			},
+			{
+				name:   "Dtrsv",
+				maxIdx: triangularMaxIndex(n, lda),
+				reqLen: lda*(n-1) + n,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dtrsv(ul, blas.NoTrans, blas.Unit, n, a, lda, x, 1) },
+			},
+			{
+				name:   "Dtbmv",
+				maxIdx: triangularBandMaxIndex(ul, n, k, ldt),
+				reqLen: ldt*(n-1) + k + 1,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dtbmv(ul, blas.NoTrans, blas.Unit, n, k, a, ldt, x, 1) },
+			},
+			{
+				name:   "Dtbsv",
+				maxIdx: triangularBandMaxIndex(ul, n, k, ldt),
+				reqLen: ldt*(n-1) + k + 1,
+				msg:    shortA,
+				f:      func(a []float64) { impl.Dtbsv(ul, blas.NoTrans, blas.Unit, n, k, a, ldt, x, 1) },
+			},
+			{
+				name:   "Dtpmv",
+				maxIdx: packedMaxIndex(n),
+				reqLen: n * (n + 1) / 2,
+				msg:    shortAP,
+				f:      func(ap []float64) { impl.Dtpmv(ul, blas.NoTrans, blas.Unit, n, ap, x, 1) },
+			},
+			{
+				name:   "Dtpsv",
+				maxIdx: packedMaxIndex(n),
+				reqLen: n * (n + 1) / 2,
+				msg:    shortAP,
+				f:      func(ap []float64) { impl.Dtpsv(ul, blas.NoTrans, blas.Unit, n, ap, x, 1) },
+			},
+		} {
+			name := fmt.Sprintf("%s,ul=%c", test.name, ul)
+
+			var want interface{} = test.msg
+			if checkBounds {
+				want = fmt.Sprintf("%s: index %d out of range for length %d", test.msg, test.maxIdx, test.maxIdx)
+			}
+			got := panicValue(func() { test.f(make([]float64, test.maxIdx)) })
+			if got != want {
+				t.Errorf("%s: unexpected panic for slice too short for the kernel: got %v, want %q", name, got, want)
+			}
+
+			if test.maxIdx+1 < test.reqLen {
+				got := panicValue(func() { test.f(make([]float64, test.maxIdx+1)) })
+				if got != test.msg {
+					t.Errorf("%s: unexpected panic for slice shorter than required: got %v, want %q", name, got, test.msg)
+				}
+			}
+
+			if got := panicValue(func() { test.f(make([]float64, test.reqLen)) }); got != nil {
+				t.Errorf("%s: unexpected panic for slice of required length: %v", name, got)
+			}
+		}
+	}
+}
+
+func panics(f func()) (b bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			b = true
+		}
+	}()
+	f()
+	return false
+}
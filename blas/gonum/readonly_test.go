@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The tests in this file check that the Level 2 routines never modify the
+// input matrix and that elements of the matrix slice that are documented as
+// never accessed do not influence the result. All such elements are set to
+// NaN so that any read of them shows up in the output.
+
+var readOnlyIncs = [][2]int{{1, 1}, {2, 3}, {-3, 2}, {1, -1}, {-2, -4}}
+
+func TestDgemvReadOnly(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {3, 4}, {4, 3}, {7, 7}} {
+			m, n := mn[0], mn[1]
+			for _, lda := range []int{n, n + 3} {
+				for _, inc := range readOnlyIncs {
+					a := make([]float64, m*lda)
+					for i := 0; i < m; i++ {
+						for j := 0; j < lda; j++ {
+							if j < n {
+								a[i*lda+j] = rnd.NormFloat64()
+							} else {
+								a[i*lda+j] = math.NaN()
+							}
+						}
+					}
+					lenX, lenY := n, m
+					if tA != blas.NoTrans {
+						lenX, lenY = m, n
+					}
+					x := randvec(lenX, inc[0], rnd)
+					y := randvec(lenY, inc[1], rnd)
+					aCopy := make([]float64, len(a))
+					copy(aCopy, a)
+
+					impl.Dgemv(tA, m, n, 1.5, a, lda, x, inc[0], 0.5, y, inc[1])
+
+					prefix := fmt.Sprintf("tA=%v,m=%v,n=%v,lda=%v,incX=%v,incY=%v", tA, m, n, lda, inc[0], inc[1])
+					checkReadOnly(t, "Dgemv", prefix, a, aCopy, y)
+				}
+			}
+		}
+	}
+}
+
+func TestDgbmvReadOnly(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {3, 4}, {4, 3}, {7, 7}} {
+			m, n := mn[0], mn[1]
+			for _, kLU := range [][2]int{{0, 0}, {1, 2}, {2, 1}, {m - 1, n - 1}, {m + 1, n + 2}} {
+				kL, kU := kLU[0], kLU[1]
+				for _, extra := range []int{0, 2} {
+					lda := kL + kU + 1 + extra
+					for _, inc := range readOnlyIncs {
+						rows := min(m, n+kL)
+						a := make([]float64, rows*lda)
+						for i := 0; i < rows; i++ {
+							for c := 0; c < lda; c++ {
+								j := i - kL + c
+								if c < kL+kU+1 && 0 <= j && j < n {
+									a[i*lda+c] = rnd.NormFloat64()
+								} else {
+									a[i*lda+c] = math.NaN()
+								}
+							}
+						}
+						lenX, lenY := n, m
+						if tA != blas.NoTrans {
+							lenX, lenY = m, n
+						}
+						x := randvec(lenX, inc[0], rnd)
+						y := randvec(lenY, inc[1], rnd)
+						aCopy := make([]float64, len(a))
+						copy(aCopy, a)
+
+						impl.Dgbmv(tA, m, n, kL, kU, 1.5, a, lda, x, inc[0], 0.5, y, inc[1])
+
+						prefix := fmt.Sprintf("tA=%v,m=%v,n=%v,kL=%v,kU=%v,lda=%v,incX=%v,incY=%v", tA, m, n, kL, kU, lda, inc[0], inc[1])
+						checkReadOnly(t, "Dgbmv", prefix, a, aCopy, y)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDsymvReadOnly(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 7} {
+			for _, lda := range []int{n, n + 3} {
+				for _, inc := range readOnlyIncs {
+					a := makeTriangularNaN(ul, blas.NonUnit, n, lda, rnd)
+					x := randvec(n, inc[0], rnd)
+					y := randvec(n, inc[1], rnd)
+					aCopy := make([]float64, len(a))
+					copy(aCopy, a)
+
+					impl.Dsymv(ul, n, 1.5, a, lda, x, inc[0], 0.5, y, inc[1])
+
+					prefix := fmt.Sprintf("ul=%v,n=%v,lda=%v,incX=%v,incY=%v", ul, n, lda, inc[0], inc[1])
+					checkReadOnly(t, "Dsymv", prefix, a, aCopy, y)
+				}
+			}
+		}
+	}
+}
+
+func TestDtrmvReadOnly(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 7} {
+					for _, lda := range []int{n, n + 3} {
+						for _, incX := range []int{1, 2, -3} {
+							a := makeTriangularNaN(ul, d, n, lda, rnd)
+							x := randvec(n, incX, rnd)
+							aCopy := make([]float64, len(a))
+							copy(aCopy, a)
+
+							impl.Dtrmv(ul, tA, d, n, a, lda, x, incX)
+
+							prefix := fmt.Sprintf("ul=%v,tA=%v,d=%v,n=%v,lda=%v,incX=%v", ul, tA, d, n, lda, incX)
+							checkReadOnly(t, "Dtrmv", prefix, a, aCopy, x)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// makeTriangularNaN returns an n×n dense matrix with random elements in the ul
+// triangle and NaN everywhere else, including the diagonal if d is blas.Unit
+// and the padding elements of each row beyond column n.
+func makeTriangularNaN(ul blas.Uplo, d blas.Diag, n, lda int, rnd *rand.Rand) []float64 {
+	a := make([]float64, n*lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < lda; j++ {
+			inTri := j < n && ((ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i))
+			if inTri && !(i == j && d == blas.Unit) {
+				a[i*lda+j] = rnd.NormFloat64()
+			} else {
+				a[i*lda+j] = math.NaN()
+			}
+		}
+	}
+	return a
+}
+
+// checkReadOnly reports an error if a differs bitwise from aCopy or if the
+// output vector contains a NaN, which can only come from an element of a
+// that must not be accessed.
+func checkReadOnly(t *testing.T, name, prefix string, a, aCopy, out []float64) {
+	t.Helper()
+	for i := range a {
+		if math.Float64bits(a[i]) != math.Float64bits(aCopy[i]) {
+			t.Errorf("%v: %v: a modified at index %v", name, prefix, i)
+			break
+		}
+	}
+	for i, v := range out {
+		if math.IsNaN(v) {
+			t.Errorf("%v: %v: unexpected NaN in output at index %v, never accessed element of a was read", name, prefix, i)
+			break
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// The kernels in this file are used when the Reproducible field of
+// Implementation is true. Each product is rounded by an explicit conversion
+// before it is added, which the Go specification guarantees prevents the
+// compiler from fusing the multiply and add, and the elements are
+// accumulated strictly in order, so the results do not depend on the
+// platform or on the assembly kernels available.
+
+// dotStrict returns the dot product of the n elements of x and y starting at
+// ix and iy with increments incX and incY.
+func dotStrict(n int, x []float64, incX, ix int, y []float64, incY, iy int) float64 {
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += float64(x[ix] * y[iy])
+		ix += incX
+		iy += incY
+	}
+	return sum
+}
+
+// axpyStrict computes y += alpha * x for the n elements of x and y starting
+// at ix and iy with increments incX and incY.
+func axpyStrict(n int, alpha float64, x []float64, incX, ix int, y []float64, incY, iy int) {
+	for i := 0; i < n; i++ {
+		y[iy] += float64(alpha * x[ix])
+		ix += incX
+		iy += incY
+	}
+}
+
+// sdotStrict returns the dot product of the n elements of x and y starting at
+// ix and iy with increments incX and incY.
+func sdotStrict(n int, x []float32, incX, ix int, y []float32, incY, iy int) float32 {
+	var sum float32
+	for i := 0; i < n; i++ {
+		sum += float32(x[ix] * y[iy])
+		ix += incX
+		iy += incY
+	}
+	return sum
+}
+
+// dsdotStrict returns the dot product of the n elements of x and y starting
+// at ix and iy with increments incX and incY, accumulated in float64. The
+// products of float32 values are exact in float64.
+func dsdotStrict(n int, x []float32, incX, ix int, y []float32, incY, iy int) float64 {
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += float64(float64(x[ix]) * float64(y[iy]))
+		ix += incX
+		iy += incY
+	}
+	return sum
+}
+
+// saxpyStrict computes y += alpha * x for the n elements of x and y starting
+// at ix and iy with increments incX and incY.
+func saxpyStrict(n int, alpha float32, x []float32, incX, ix int, y []float32, incY, iy int) {
+	for i := 0; i < n; i++ {
+		y[iy] += float32(alpha * x[ix])
+		ix += incX
+		iy += incY
+	}
+}
+
+// dgemvStrict computes
+//  y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+// for arguments that have been checked by Dgemv.
+func dgemvStrict(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	lenX, lenY := m, n
+	if tA == blas.NoTrans {
+		lenX, lenY = n, m
+	}
+	var kx, ky int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	if tA == blas.NoTrans {
+		iy := ky
+		for i := 0; i < m; i++ {
+			t := float64(alpha * dotStrict(n, a[i*lda:i*lda+n], 1, 0, x, incX, kx))
+			if beta == 0 {
+				y[iy] = t
+			} else {
+				y[iy] = float64(beta*y[iy]) + t
+			}
+			iy += incY
+		}
+		return
+	}
+
+	if beta != 1 {
+		iy := ky
+		for i := 0; i < n; i++ {
+			if beta == 0 {
+				y[iy] = 0
+			} else {
+				y[iy] *= beta
+			}
+			iy += incY
+		}
+	}
+	ix := kx
+	for i := 0; i < m; i++ {
+		axpyStrict(n, float64(alpha*x[ix]), a[i*lda:i*lda+n], 1, 0, y, incY, ky)
+		ix += incX
+	}
+}
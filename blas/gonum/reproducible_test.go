@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The inputs below are crafted so that u*v = 1 - 2⁻⁶⁰ exactly. The rounded
+// product is 1, so u*v - 1 is 0 when the product is rounded before the
+// subtraction and -2⁻⁶⁰ when the two are fused.
+var (
+	reproU = 1 + math.Ldexp(1, -30)
+	reproV = 1 - math.Ldexp(1, -30)
+)
+
+func TestReproducible(t *testing.T) {
+	impl := Implementation{Reproducible: true}
+	const want = 0.0
+
+	check := func(name string, got float64) {
+		t.Helper()
+		if math.Float64bits(got) != math.Float64bits(want) {
+			t.Errorf("%v: unexpected bit pattern: got %#x (%v), want %#x (%v)",
+				name, math.Float64bits(got), got, math.Float64bits(want), want)
+		}
+	}
+
+	for _, test := range []struct {
+		incX, incY int
+	}{
+		{incX: 1, incY: 1},
+		{incX: 2, incY: 3},
+		{incX: -1, incY: 2},
+	} {
+		// Ddot accumulates -1 + u*v.
+		x := make([]float64, 1+abs(test.incX))
+		y := make([]float64, 1+abs(test.incY))
+		x[vecIndex(0, 2, test.incX)], x[vecIndex(1, 2, test.incX)] = -1, reproU
+		y[vecIndex(0, 2, test.incY)], y[vecIndex(1, 2, test.incY)] = 1, reproV
+		check("Ddot", impl.Ddot(2, x, test.incX, y, test.incY))
+
+		// Daxpy computes -1 + u*v.
+		x = make([]float64, 1+abs(test.incX))
+		y = make([]float64, 1+abs(test.incY))
+		for i := 0; i < 2; i++ {
+			x[vecIndex(i, 2, test.incX)] = reproV
+			y[vecIndex(i, 2, test.incY)] = -1
+		}
+		impl.Daxpy(2, reproU, x, test.incX, y, test.incY)
+		for i := 0; i < 2; i++ {
+			check("Daxpy", y[vecIndex(i, 2, test.incY)])
+		}
+	}
+
+	// Dgemv with tA = blas.NoTrans forms the dot product of the row
+	// [-1 u] with x = [1 v].
+	y := []float64{math.NaN()}
+	impl.Dgemv(blas.NoTrans, 1, 2, 1, []float64{-1, reproU}, 2, []float64{1, reproV}, 1, 0, y, 1)
+	check("Dgemv NoTrans", y[0])
+
+	// Dgemv with tA = blas.Trans adds u*v to y = -1.
+	for _, beta := range []float64{1, -1} {
+		y = []float64{-beta}
+		impl.Dgemv(blas.Trans, 1, 1, 1, []float64{reproU}, 1, []float64{reproV}, 1, beta, y, 1)
+		check("Dgemv Trans", y[0])
+	}
+}
+
+// TestReproducibleMatchesDefault checks that the reproducible kernels agree
+// with the default kernels up to rounding on ordinary input.
+func TestReproducibleMatchesDefault(t *testing.T) {
+	const tol = 1e-13
+
+	repro := Implementation{Reproducible: true}
+	x := []float64{1, -2, 3, 0.5, 4, -1.5, 2.5}
+	y := []float64{0.25, 1, -3, 2, 1.5, -0.5, 3}
+	if got, want := repro.Ddot(len(x), x, 1, y, 1), impl.Ddot(len(x), x, 1, y, 1); math.Abs(got-want) > tol {
+		t.Errorf("Ddot: got %v, want %v", got, want)
+	}
+
+	a := []float64{
+		1, 2, 3,
+		-1, 0.5, 2,
+		4, -3, 1,
+	}
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, beta := range []float64{0, 1, -0.5} {
+			got := []float64{1, 2, 3, 4, 5}
+			want := []float64{1, 2, 3, 4, 5}
+			repro.Dgemv(tA, 3, 3, 1.5, a, 3, x, 2, beta, got, -2)
+			impl.Dgemv(tA, 3, 3, 1.5, a, 3, x, 2, beta, want, -2)
+			for i := range want {
+				if math.Abs(got[i]-want[i]) > tol {
+					t.Errorf("Dgemv tA=%c,beta=%v: unexpected result at %v: got %v, want %v", tA, beta, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
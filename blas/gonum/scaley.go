@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/internal/asm/f32"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// dscalePrepY forms y = beta * y for the vector y of length n with increment
+// incY, as done by the Level 2 routines before the matrix-vector product is
+// added. If beta is one y is not touched, and if beta is zero y is set to
+// zero without being read, so NaN and infinite values in y do not propagate.
+// A negative incY refers to the same elements as its absolute value.
+func dscalePrepY(n int, beta float64, y []float64, incY int) {
+	if beta == 1 || n == 0 {
+		return
+	}
+	if incY < 0 {
+		incY = -incY
+	}
+	if beta == 0 {
+		for iy := 0; iy < n*incY; iy += incY {
+			y[iy] = 0
+		}
+		return
+	}
+	if incY == 1 {
+		f64.ScalUnitary(beta, y[:n])
+		return
+	}
+	f64.ScalInc(beta, y, uintptr(n), uintptr(incY))
+}
+
+// sscalePrepY is the single precision version of dscalePrepY.
+func sscalePrepY(n int, beta float32, y []float32, incY int) {
+	if beta == 1 || n == 0 {
+		return
+	}
+	if incY < 0 {
+		incY = -incY
+	}
+	if beta == 0 {
+		for iy := 0; iy < n*incY; iy += incY {
+			y[iy] = 0
+		}
+		return
+	}
+	if incY == 1 {
+		f32.ScalUnitary(beta, y[:n])
+		return
+	}
+	f32.ScalInc(beta, y, uintptr(n), uintptr(incY))
+}
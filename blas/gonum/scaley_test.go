@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// TestScalePrepYUniform checks that the general and the symmetric Level 2
+// matrix-vector routines treat alpha and beta in the same way: y is not read
+// when beta is zero, only scaled by beta when alpha is zero, and left
+// unchanged when in addition beta is one. The same symmetric band matrix is
+// passed to each routine in its own storage format.
+func TestScalePrepYUniform(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 9} {
+		for _, k := range []int{0, 1, 3} {
+			// Build the symmetric band matrix in dense, general band,
+			// upper symmetric band and upper packed storage.
+			kk := min(k, n-1)
+			dense := make([]float64, n*n)
+			for i := 0; i < n; i++ {
+				for j := i; j <= min(n-1, i+kk); j++ {
+					v := rnd.NormFloat64()
+					dense[i*n+j] = v
+					dense[j*n+i] = v
+				}
+			}
+			ldgb := 2*kk + 1
+			gb := denseToBand(n, n, kk, kk, dense, ldgb, math.NaN())
+			ldsb := kk + 1
+			sb := nanSlice(n * ldsb)
+			var ap []float64
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					if j <= i+kk {
+						sb[i*ldsb+j-i] = dense[i*n+j]
+					}
+					ap = append(ap, dense[i*n+j])
+				}
+			}
+
+			routines := []struct {
+				name string
+				f    func(alpha float64, x []float64, incX int, beta float64, y []float64, incY int)
+			}{
+				{"Dgemv", func(alpha float64, x []float64, incX int, beta float64, y []float64, incY int) {
+					impl.Dgemv(blas.NoTrans, n, n, alpha, dense, n, x, incX, beta, y, incY)
+				}},
+				{"Dgbmv", func(alpha float64, x []float64, incX int, beta float64, y []float64, incY int) {
+					impl.Dgbmv(blas.NoTrans, n, n, kk, kk, alpha, gb, ldgb, x, incX, beta, y, incY)
+				}},
+				{"Dsymv", func(alpha float64, x []float64, incX int, beta float64, y []float64, incY int) {
+					impl.Dsymv(blas.Upper, n, alpha, dense, n, x, incX, beta, y, incY)
+				}},
+				{"Dsbmv", func(alpha float64, x []float64, incX int, beta float64, y []float64, incY int) {
+					impl.Dsbmv(blas.Upper, n, kk, alpha, sb, ldsb, x, incX, beta, y, incY)
+				}},
+				{"Dspmv", func(alpha float64, x []float64, incX int, beta float64, y []float64, incY int) {
+					impl.Dspmv(blas.Upper, n, alpha, ap, x, incX, beta, y, incY)
+				}},
+			}
+
+			for _, inc := range []struct{ x, y int }{{1, 1}, {2, 3}, {-1, 2}, {1, -2}} {
+				for _, alpha := range []float64{0, 1, -2.5} {
+					for _, beta := range []float64{0, 1, 0.5} {
+						prefix := fmt.Sprintf("n=%v,k=%v,incX=%v,incY=%v,alpha=%v,beta=%v", n, k, inc.x, inc.y, alpha, beta)
+						x := randvec(n, inc.x, rnd)
+						y := randvec(n, inc.y, rnd)
+						if beta == 0 {
+							// y must not be read.
+							for i := 0; i < n; i++ {
+								y[vecIndex(i, n, inc.y)] = math.NaN()
+							}
+						}
+
+						// The reference result is formed from the dense
+						// matrix, starting from y = beta*y with beta*NaN
+						// taken to be zero.
+						want := make([]float64, len(y))
+						copy(want, y)
+						for i := 0; i < n; i++ {
+							iy := vecIndex(i, n, inc.y)
+							if beta == 0 {
+								want[iy] = 0
+							} else {
+								want[iy] *= beta
+							}
+							var sum float64
+							for j := 0; j < n; j++ {
+								sum += dense[i*n+j] * x[vecIndex(j, n, inc.x)]
+							}
+							want[iy] += alpha * sum
+						}
+
+						for _, r := range routines {
+							name := r.name + "/" + prefix
+							got := make([]float64, len(y))
+							copy(got, y)
+							r.f(alpha, x, inc.x, beta, got, inc.y)
+							if alpha == 0 {
+								// No product is formed, so the result is exact.
+								if !floats.Same(got, want) {
+									t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+								}
+								continue
+							}
+							if !floats.EqualApprox(got, want, tol) {
+								t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
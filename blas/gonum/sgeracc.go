@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// SgerAccF64 performs the rank-one operation
+//  A += alpha * x * yᵀ
+// where A is an m×n dense float32 matrix, x and y are vectors, and alpha is a
+// scalar, accumulating the update in float64.
+//
+// acc is an m×n dense float64 matrix with leading dimension ldAcc that must
+// hold the values of A on entry, for example by converting a before the first
+// call. The update is added to acc in float64 precision and the result rounded
+// to float32 is stored into a. Reusing acc over a long sequence of updates
+// avoids the drift caused by rounding A to float32 after every update as Sger
+// does.
+func (Implementation) SgerAccF64(m, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int, acc []float64, ldAcc int) {
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if ldAcc < max(1, n) {
+		panic(badLdAcc)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+	if len(acc) < ldAcc*(m-1)+n {
+		panic(shortAcc)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 {
+		return
+	}
+
+	var kx, ky int
+	if incX < 0 {
+		kx = -(m - 1) * incX
+	}
+	if incY < 0 {
+		ky = -(n - 1) * incY
+	}
+	ix := kx
+	for i := 0; i < m; i++ {
+		tmp := float64(alpha) * float64(x[ix])
+		atmp := a[i*lda : i*lda+n]
+		acctmp := acc[i*ldAcc : i*ldAcc+n]
+		jy := ky
+		for j := range acctmp {
+			acctmp[j] += tmp * float64(y[jy])
+			atmp[j] = float32(acctmp[j])
+			jy += incY
+		}
+		ix += incX
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSgerAccF64(t *testing.T) {
+	const (
+		m, n    = 7, 5
+		lda     = n + 2
+		ldAcc   = n + 1
+		updates = 10000
+		alpha   = 1e-3
+	)
+	rnd := rand.New(rand.NewSource(1))
+
+	a := make([]float32, m*lda)
+	for i := range a {
+		a[i] = float32(rnd.NormFloat64())
+	}
+	aSger := make([]float32, len(a))
+	copy(aSger, a)
+	ref := make([]float64, m*n)
+	acc := make([]float64, m*ldAcc)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			ref[i*n+j] = float64(a[i*lda+j])
+			acc[i*ldAcc+j] = float64(a[i*lda+j])
+		}
+	}
+
+	for _, inc := range [][2]int{{1, 1}, {2, -3}} {
+		incX, incY := inc[0], inc[1]
+		x := make([]float32, 1+(m-1)*abs(incX))
+		y := make([]float32, 1+(n-1)*abs(incY))
+		for k := 0; k < updates/2; k++ {
+			for i := range x {
+				x[i] = float32(rnd.NormFloat64())
+			}
+			for i := range y {
+				y[i] = float32(rnd.NormFloat64())
+			}
+			impl.SgerAccF64(m, n, alpha, x, incX, y, incY, a, lda, acc, ldAcc)
+			impl.Sger(m, n, alpha, x, incX, y, incY, aSger, lda)
+			for i := 0; i < m; i++ {
+				xi := float64(x[vecIndex(i, m, incX)])
+				for j := 0; j < n; j++ {
+					yj := float64(y[vecIndex(j, n, incY)])
+					ref[i*n+j] += float64(float32(alpha)) * xi * yj
+				}
+			}
+		}
+	}
+
+	var errAcc, errSger float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			r := ref[i*n+j]
+			if d := math.Abs(acc[i*ldAcc+j] - r); d > 1e-12 {
+				t.Errorf("accumulator mismatch at (%v,%v): got %v, want %v", i, j, acc[i*ldAcc+j], r)
+			}
+			if a[i*lda+j] != float32(acc[i*ldAcc+j]) {
+				t.Errorf("a not rounded accumulator at (%v,%v): got %v, want %v", i, j, a[i*lda+j], float32(acc[i*ldAcc+j]))
+			}
+			errAcc = math.Max(errAcc, math.Abs(float64(a[i*lda+j])-r))
+			errSger = math.Max(errSger, math.Abs(float64(aSger[i*lda+j])-r))
+		}
+	}
+	// SgerAccF64 only incurs one final rounding to float32.
+	if errAcc > 1e-6 {
+		t.Errorf("unexpected error with float64 accumulation: %v", errAcc)
+	}
+	if errAcc >= errSger {
+		t.Errorf("float64 accumulation did not reduce drift: accumulated error %v, Sger error %v", errAcc, errSger)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "sync/atomic"
+
+// SkipCounter counts the rows of A left unchanged by the rank-one updates Dger
+// and Dsyr, and their single precision equivalents, because the corresponding
+// element of x is NaN or infinite. A SkipCounter is safe for concurrent use.
+//
+// Skipping is enabled by setting the SkipNonFinite field of an Implementation.
+type SkipCounter struct {
+	n uint64
+}
+
+// Skipped returns the number of rows skipped since the counter was created or
+// last reset.
+func (c *SkipCounter) Skipped() uint64 {
+	return atomic.LoadUint64(&c.n)
+}
+
+// Reset sets the count to zero.
+func (c *SkipCounter) Reset() {
+	atomic.StoreUint64(&c.n, 0)
+}
+
+// add adds n to the count if c is not nil.
+func (c *SkipCounter) add(n int) {
+	if c == nil {
+		return
+	}
+	atomic.AddUint64(&c.n, uint64(n))
+}
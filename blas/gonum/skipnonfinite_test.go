@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+var nonFiniteValues = []float64{math.NaN(), math.Inf(1), math.Inf(-1)}
+
+func TestDgerSkipNonFinite(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+		bad  []int
+	}{
+		{m: 1, n: 3, bad: nil},
+		{m: 1, n: 3, bad: []int{0}},
+		{m: 4, n: 3, bad: []int{1}},
+		{m: 5, n: 7, bad: []int{0, 4}},
+		{m: 9, n: 4, bad: []int{2, 3, 7}},
+		{m: 6, n: 6, bad: []int{0, 1, 2, 3, 4, 5}},
+	} {
+		m, n := test.m, test.n
+		for _, inc := range []struct{ x, y int }{{1, 1}, {2, 1}, {-3, 2}, {1, -1}} {
+			for _, lda := range []int{n, n + 3} {
+				name := fmt.Sprintf("m=%v,n=%v,bad=%v,incX=%v,incY=%v,lda=%v", m, n, test.bad, inc.x, inc.y, lda)
+				x := randvec(m, inc.x, rnd)
+				y := randvec(n, inc.y, rnd)
+				a := randvec(m*lda, 1, rnd)
+				// xZero is x with the non-finite elements set to zero.
+				xZero := make([]float64, len(x))
+				copy(xZero, x)
+				for k, i := range test.bad {
+					ix := vecIndex(i, m, inc.x)
+					x[ix] = nonFiniteValues[k%len(nonFiniteValues)]
+					xZero[ix] = 0
+				}
+				const alpha = 1.5
+
+				want := make([]float64, len(a))
+				copy(want, a)
+				impl.Dger(m, n, alpha, xZero, inc.x, y, inc.y, want, lda)
+
+				var c SkipCounter
+				got := make([]float64, len(a))
+				copy(got, a)
+				Implementation{SkipNonFinite: &c}.Dger(m, n, alpha, x, inc.x, y, inc.y, got, lda)
+				if !floats.Same(got, want) {
+					t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+				}
+				for _, i := range test.bad {
+					if !floats.Same(got[i*lda:i*lda+n], a[i*lda:i*lda+n]) {
+						t.Errorf("%v: row %v modified", name, i)
+					}
+				}
+				if c.Skipped() != uint64(len(test.bad)) {
+					t.Errorf("%v: unexpected skip count: got %v, want %v", name, c.Skipped(), len(test.bad))
+				}
+
+				// Without the flag the non-finite values propagate.
+				copy(got, a)
+				impl.Dger(m, n, alpha, x, inc.x, y, inc.y, got, lda)
+				for _, i := range test.bad {
+					for j := 0; j < n; j++ {
+						if !math.IsNaN(got[i*lda+j]) && !math.IsInf(got[i*lda+j], 0) {
+							t.Errorf("%v: non-finite x[%v] did not propagate to a[%v,%v]", name, i, i, j)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDsyrSkipNonFinite(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n   int
+		bad []int
+	}{
+		{n: 1, bad: nil},
+		{n: 1, bad: []int{0}},
+		{n: 4, bad: []int{1}},
+		{n: 7, bad: []int{0, 6}},
+		{n: 9, bad: []int{2, 3, 7}},
+	} {
+		n := test.n
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, incX := range []int{1, 2, -3} {
+				for _, lda := range []int{n, n + 2} {
+					name := fmt.Sprintf("uplo=%c,n=%v,bad=%v,incX=%v,lda=%v", uplo, n, test.bad, incX, lda)
+					x := randvec(n, incX, rnd)
+					a := randvec(n*lda, 1, rnd)
+					xZero := make([]float64, len(x))
+					copy(xZero, x)
+					isBad := make([]bool, n)
+					for k, i := range test.bad {
+						ix := vecIndex(i, n, incX)
+						x[ix] = nonFiniteValues[k%len(nonFiniteValues)]
+						xZero[ix] = 0
+						isBad[i] = true
+					}
+					const alpha = -0.75
+
+					want := make([]float64, len(a))
+					copy(want, a)
+					impl.Dsyr(uplo, n, alpha, xZero, incX, want, lda)
+
+					var c SkipCounter
+					xCopy := make([]float64, len(x))
+					copy(xCopy, x)
+					got := make([]float64, len(a))
+					copy(got, a)
+					Implementation{SkipNonFinite: &c}.Dsyr(uplo, n, alpha, x, incX, got, lda)
+					if !floats.Same(got, want) {
+						t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, want, got)
+					}
+					if !floats.Same(x, xCopy) {
+						t.Errorf("%v: x modified", name)
+					}
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+								continue
+							}
+							if (isBad[i] || isBad[j]) && got[i*lda+j] != a[i*lda+j] {
+								t.Errorf("%v: element a[%v,%v] of a skipped row modified", name, i, j)
+							}
+						}
+					}
+					if c.Skipped() != uint64(len(test.bad)) {
+						t.Errorf("%v: unexpected skip count: got %v, want %v", name, c.Skipped(), len(test.bad))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSkipCounterAccumulates(t *testing.T) {
+	var c SkipCounter
+	skip := Implementation{SkipNonFinite: &c}
+	x := []float64{1, math.NaN(), 2}
+	a := make([]float64, 9)
+	skip.Dger(3, 3, 1, x, 1, []float64{1, 1, 1}, 1, a, 3)
+	skip.Dsyr(blas.Upper, 3, 1, x, 1, a, 3)
+	if c.Skipped() != 2 {
+		t.Errorf("unexpected skip count: got %v, want 2", c.Skipped())
+	}
+	c.Reset()
+	if c.Skipped() != 0 {
+		t.Errorf("unexpected skip count after reset: got %v, want 0", c.Skipped())
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestStrictDiag(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	strict := Implementation{StrictDiag: true}
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, n := range []int{1, 2, 5} {
+				for _, incX := range []int{1, -2} {
+					lda := n + 1
+					a := randomTriangular(rnd, ul, n, lda)
+					x := randvec(n, incX, rnd)
+
+					// Without a zero on the diagonal the strict check
+					// does not change the result.
+					name := fmt.Sprintf("ul=%c,tA=%c,n=%d,incX=%d", ul, tA, n, incX)
+					want := make([]float64, len(x))
+					copy(want, x)
+					impl.Dtrsv(ul, tA, blas.NonUnit, n, a, lda, want, incX)
+					got := make([]float64, len(x))
+					copy(got, x)
+					strict.Dtrsv(ul, tA, blas.NonUnit, n, a, lda, got, incX)
+					if !floats.Same(got, want) {
+						t.Errorf("%s: strict result differs from Dtrsv", name)
+					}
+
+					for zero := 0; zero < n; zero++ {
+						name := fmt.Sprintf("%s,zero=%d", name, zero)
+						aZero := make([]float64, len(a))
+						copy(aZero, a)
+						aZero[zero*lda+zero] = 0
+						if zero+1 < n {
+							// Only the first zero is reported.
+							aZero[(n-1)*lda+n-1] = 0
+						}
+
+						copy(got, x)
+						p := panicValue(func() { strict.Dtrsv(ul, tA, blas.NonUnit, n, aZero, lda, got, incX) })
+						if wantPanic := fmt.Sprintf("%s at index %d", zeroDiag, zero); p != wantPanic {
+							t.Errorf("%s: unexpected panic: got %v, want %q", name, p, wantPanic)
+						}
+						if !floats.Same(got, x) {
+							t.Errorf("%s: x modified before panic", name)
+						}
+
+						// The diagonal is not referenced for a unit
+						// triangular matrix, and the check is off by
+						// default.
+						if p := panicValue(func() { strict.Dtrsv(ul, tA, blas.Unit, n, aZero, lda, got, incX) }); p != nil {
+							t.Errorf("%s: unexpected panic for unit diagonal: %v", name, p)
+						}
+						if p := panicValue(func() { impl.Dtrsv(ul, tA, blas.NonUnit, n, aZero, lda, got, incX) }); p != nil {
+							t.Errorf("%s: unexpected panic without StrictDiag: %v", name, p)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestStrictPacked(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	strict := Implementation{StrictPacked: true}
+	for _, n := range []int{1, 2, 5} {
+		size := n * (n + 1) / 2
+		for _, lenAP := range []int{size, size + 1, n * n} {
+			ap := randvec(lenAP, 1, rnd)
+			for i := 0; i < n; i++ {
+				// Keep the solve well conditioned.
+				ap[packedDiagIndex(blas.Upper, n, i)] = 2 + rnd.Float64()
+			}
+			x := randvec(n, 1, rnd)
+			for _, test := range []struct {
+				name string
+				f    func(impl Implementation)
+			}{
+				{name: "Dtpmv", f: func(impl Implementation) {
+					impl.Dtpmv(blas.Upper, blas.NoTrans, blas.NonUnit, n, ap, x, 1)
+				}},
+				{name: "Dtpsv", f: func(impl Implementation) {
+					impl.Dtpsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, ap, x, 1)
+				}},
+			} {
+				name := fmt.Sprintf("%v/n=%v,len(ap)=%v", test.name, n, lenAP)
+				if panics(func() { test.f(Implementation{}) }) {
+					t.Errorf("%v: unexpected panic without strict check", name)
+				}
+				wantPanic := lenAP != size
+				if got := panics(func() { test.f(strict) }); got != wantPanic {
+					t.Errorf("%v: unexpected strict panic: got %v, want %v", name, got, wantPanic)
+				}
+			}
+		}
+	}
+
+	// A short ap is reported as short regardless of the strict check.
+	var msg interface{}
+	func() {
+		defer func() { msg = recover() }()
+		strict.Dtpmv(blas.Upper, blas.NoTrans, blas.NonUnit, 3, make([]float64, 5), make([]float64, 3), 1)
+	}()
+	var want interface{} = shortAP
+	if checkBounds {
+		// The pre-flight check runs before the length check of Dtpmv.
+		want = panicValue(func() { preflight(5, packedMaxIndex(3), shortAP) })
+	}
+	if msg != want {
+		t.Errorf("unexpected panic for short ap: got %v, want %v", msg, want)
+	}
+}
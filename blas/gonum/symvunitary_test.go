@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/testblas"
+)
+
+// TestSymvUnitary checks that the unit increment paths of Dsymv and Dspmv,
+// which use the Level 1 kernels, agree with the scalar loops used for
+// non-unit increments of y.
+func TestSymvUnitary(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	// Force the unit increment paths for all n.
+	th := DefaultThresholds()
+	th.SymvUnitary = 0
+	unitary := Implementation{Thresholds: &th}
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 3, 4, 7, 16, 33} {
+			for _, ab := range [][2]float64{{0, 0.5}, {1, 0}, {1, 1}, {-2.5, 0.5}} {
+				alpha, beta := ab[0], ab[1]
+				name := fmt.Sprintf("ul=%c,n=%v,alpha=%v,beta=%v", ul, n, alpha, beta)
+				lda := n + 2
+				a := randvec(n*lda, 1, rnd)
+				ap := randvec(n*(n+1)/2, 1, rnd)
+				x := randvec(n, 1, rnd)
+				y := randvec(n, 1, rnd)
+
+				got := make([]float64, n)
+				copy(got, y)
+				unitary.Dsymv(ul, n, alpha, a, lda, x, 1, beta, got, 1)
+				want := make([]float64, 1+(n-1)*2)
+				for i, v := range y {
+					want[2*i] = v
+				}
+				impl.Dsymv(ul, n, alpha, a, lda, x, 1, beta, want, 2)
+				checkSymvUnitary(t, "Dsymv/"+name, n, got, want)
+
+				copy(got, y)
+				unitary.Dspmv(ul, n, alpha, ap, x, 1, beta, got, 1)
+				for i, v := range y {
+					want[2*i] = v
+				}
+				impl.Dspmv(ul, n, alpha, ap, x, 1, beta, want, 2)
+				checkSymvUnitary(t, "Dspmv/"+name, n, got, want)
+			}
+		}
+	}
+}
+
+func checkSymvUnitary(t *testing.T, name string, n int, got, want []float64) {
+	// The elements of the matrix and the vectors are normally distributed,
+	// so the result elements are O(√n) and the summation order differences
+	// O(n) ulps.
+	tol := float64(4*n) * 0x1p-52
+	for i := 0; i < n; i++ {
+		if math.Abs(got[i]-want[2*i]) > tol*math.Max(1, math.Abs(want[2*i])) {
+			t.Errorf("%v: result differs from scalar path at %v: got %v, want %v", name, i, got[i], want[2*i])
+		}
+	}
+}
+
+func BenchmarkDsymvUnitary(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			// incY = 2 runs the scalar loop over a unit increment x.
+			for _, incY := range []int{1, 2} {
+				b.Run(fmt.Sprintf("n=%d,ul=%c,incY=%d", n, ul, incY), func(b *testing.B) {
+					testblas.DsymvBenchmark(b, impl, ul, n, 1, incY)
+				})
+			}
+		}
+	}
+}
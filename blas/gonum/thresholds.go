@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// Thresholds holds the problem sizes at which routines switch from their
+// general code to an optimized path. A path is taken when the size reaches
+// the threshold, so a threshold of zero always selects the optimized path
+// and a threshold of the largest int never does. The optimized paths are also
+// subject to their own conditions, such as unit increments, which are listed
+// with each field.
+type Thresholds struct {
+	// GemmParallel is the number of blockSize×blockSize blocks
+	// of C at which Dgemm and Sgemm compute the blocks
+	// concurrently.
+	GemmParallel int
+
+	// GemvUnroll is the number of columns of A at which Dgemv
+	// accumulates the row dot products for tA == blas.NoTrans
+	// and incX == 1 in four partial sums. It has an effect only
+	// when built with the gemvunroll tag.
+	GemvUnroll int
+
+	// SymvUnitary is the order of A at which Dsymv and Dspmv,
+	// and their single precision counterparts, use the Level 1
+	// Axpy and Dot kernels when incX and incY are both 1.
+	SymvUnitary int
+
+	// GbmvTridiag is the number of columns of A at which
+	// Dgbmv uses its tridiagonal kernel when kL and kU are
+	// both 1.
+	GbmvTridiag int
+}
+
+// defaultThresholds holds the thresholds used when the Thresholds field of
+// Implementation is nil. They were chosen with benchmarks on amd64. The
+// concurrent Dgemm does not pay for starting its workers below four blocks,
+// and the calls to the Level 1 kernels in Dsymv cost more than they save for
+// n below about 32. The tridiagonal Dgbmv and the unrolled Dgemv are faster
+// at all sizes.
+var defaultThresholds = Thresholds{
+	GemmParallel: 4,
+	GemvUnroll:   0,
+	SymvUnitary:  32,
+	GbmvTridiag:  0,
+}
+
+// DefaultThresholds returns the thresholds used by an Implementation with a
+// nil Thresholds field. The result may be modified and assigned to the field
+// to tune individual thresholds.
+func DefaultThresholds() Thresholds {
+	return defaultThresholds
+}
+
+// thresholds returns the thresholds in effect for the receiver.
+func (impl Implementation) thresholds() *Thresholds {
+	if impl.Thresholds == nil {
+		return &defaultThresholds
+	}
+	return impl.Thresholds
+}
+
+// useParallelGemm returns whether Dgemm and Sgemm compute the m×n result in
+// concurrent blocks.
+func (impl Implementation) useParallelGemm(m, n int) bool {
+	return blocks(m, blockSize)*blocks(n, blockSize) >= impl.thresholds().GemmParallel
+}
+
+// useUnrolledGemv returns whether Dgemv with tA == blas.NoTrans uses the
+// unrolled row dot products for A with n columns.
+func (impl Implementation) useUnrolledGemv(n, incX int) bool {
+	return unrollGemv && incX == 1 && n >= impl.thresholds().GemvUnroll
+}
+
+// useUnitarySymv returns whether the symmetric matrix-vector routines of
+// order n use the Level 1 kernels.
+func (impl Implementation) useUnitarySymv(n, incX, incY int) bool {
+	return incX == 1 && incY == 1 && n >= impl.thresholds().SymvUnitary
+}
+
+// useTridiagGbmv returns whether Dgbmv uses its tridiagonal kernel for A with
+// n columns.
+func (impl Implementation) useTridiagGbmv(n, kL, kU int) bool {
+	return kL == 1 && kU == 1 && n >= impl.thresholds().GbmvTridiag
+}
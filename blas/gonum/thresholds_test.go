@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// maxInt is the largest int, a threshold that is never reached.
+const maxInt = int(^uint(0) >> 1)
+
+// thresholdsWith returns an Implementation with the default thresholds
+// except for the field modified by set.
+func thresholdsWith(set func(th *Thresholds)) Implementation {
+	th := DefaultThresholds()
+	set(&th)
+	return Implementation{Thresholds: &th}
+}
+
+func TestThresholdsSelectPath(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		set  func(th *Thresholds, v int)
+		// use reports whether the optimized path is taken for the
+		// smallest and for a large eligible problem.
+		use func(impl Implementation, large bool) bool
+	}{
+		{
+			name: "GemmParallel",
+			set:  func(th *Thresholds, v int) { th.GemmParallel = v },
+			use: func(impl Implementation, large bool) bool {
+				if large {
+					return impl.useParallelGemm(100*blockSize, 100*blockSize)
+				}
+				return impl.useParallelGemm(1, 1)
+			},
+		},
+		{
+			name: "SymvUnitary",
+			set:  func(th *Thresholds, v int) { th.SymvUnitary = v },
+			use: func(impl Implementation, large bool) bool {
+				if large {
+					return impl.useUnitarySymv(1e6, 1, 1)
+				}
+				return impl.useUnitarySymv(1, 1, 1)
+			},
+		},
+		{
+			name: "GbmvTridiag",
+			set:  func(th *Thresholds, v int) { th.GbmvTridiag = v },
+			use: func(impl Implementation, large bool) bool {
+				if large {
+					return impl.useTridiagGbmv(1e6, 1, 1)
+				}
+				return impl.useTridiagGbmv(1, 1, 1)
+			},
+		},
+		{
+			name: "GemvUnroll",
+			set:  func(th *Thresholds, v int) { th.GemvUnroll = v },
+			use: func(impl Implementation, large bool) bool {
+				if large {
+					return impl.useUnrolledGemv(1e6, 1)
+				}
+				return impl.useUnrolledGemv(1, 1)
+			},
+		},
+	} {
+		fast := thresholdsWith(func(th *Thresholds) { test.set(th, 0) })
+		slow := thresholdsWith(func(th *Thresholds) { test.set(th, maxInt) })
+		for _, large := range []bool{false, true} {
+			// The unrolled Dgemv is only available with the gemvunroll tag.
+			want := test.name != "GemvUnroll" || unrollGemv
+			if got := test.use(fast, large); got != want {
+				t.Errorf("%v: threshold 0 with large=%v: got optimized path %v, want %v", test.name, large, got, want)
+			}
+			if test.use(slow, large) {
+				t.Errorf("%v: threshold maxInt with large=%v selected the optimized path", test.name, large)
+			}
+		}
+	}
+
+	// The path conditions other than the size are still respected.
+	fast := thresholdsWith(func(th *Thresholds) { *th = Thresholds{} })
+	if fast.useUnitarySymv(10, 2, 1) || fast.useUnitarySymv(10, 1, -1) {
+		t.Errorf("SymvUnitary: threshold 0 selected the unit increment path for non-unit increments")
+	}
+	if fast.useTridiagGbmv(10, 2, 1) || fast.useTridiagGbmv(10, 1, 0) {
+		t.Errorf("GbmvTridiag: threshold 0 selected the tridiagonal path for a wider band")
+	}
+	if fast.useUnrolledGemv(10, 2) {
+		t.Errorf("GemvUnroll: threshold 0 selected the unrolled path for incX != 1")
+	}
+}
+
+func TestThresholdsDefault(t *testing.T) {
+	var impl Implementation
+	if *impl.thresholds() != DefaultThresholds() {
+		t.Errorf("nil Thresholds does not use the defaults")
+	}
+	th := DefaultThresholds()
+	th.GemmParallel = 0
+	if defaultThresholds.GemmParallel == 0 {
+		t.Errorf("modifying the result of DefaultThresholds changed the defaults")
+	}
+}
+
+// TestThresholdsResults checks that the routines with thresholds give
+// correct results on both sides of each threshold.
+func TestThresholdsResults(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, v := range []int{0, maxInt} {
+		impl := Implementation{Thresholds: &Thresholds{
+			GemmParallel: v,
+			GemvUnroll:   v,
+			SymvUnitary:  v,
+			GbmvTridiag:  v,
+		}}
+		for _, n := range []int{1, 5, 70} {
+			name := fmt.Sprintf("threshold=%v,n=%v", v, n)
+			a := randvec(n*n, 1, rnd)
+			b := randvec(n*n, 1, rnd)
+			x := randvec(n, 1, rnd)
+			y := randvec(n, 1, rnd)
+
+			// Dgemm against a sequence of Dgemv calls.
+			got := make([]float64, n*n)
+			impl.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, a, n, b, n, 0, got, n)
+			want := make([]float64, n*n)
+			for i := 0; i < n; i++ {
+				Implementation{}.Dgemv(blas.Trans, n, n, 1, b, n, a[i*n:], 1, 0, want[i*n:], 1)
+			}
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%v: unexpected Dgemm result", name)
+			}
+
+			// Dgemv against the naive reference.
+			got = make([]float64, n)
+			copy(got, y)
+			impl.Dgemv(blas.NoTrans, n, n, 1.5, a, n, x, 1, 0.5, got, 1)
+			want = make([]float64, n)
+			copy(want, y)
+			naiveGemv(blas.NoTrans, n, n, 1.5, a, n, x, 1, 0.5, want, 1)
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%v: unexpected Dgemv result", name)
+			}
+
+			// Dsymv against the strided path.
+			copy(got, y)
+			impl.Dsymv(blas.Upper, n, 1.5, a, n, x, 1, 0.5, got, 1)
+			strided := make([]float64, 2*n)
+			for i, v := range y {
+				strided[2*i] = v
+			}
+			impl.Dsymv(blas.Upper, n, 1.5, a, n, x, 1, 0.5, strided, 2)
+			for i := range got {
+				if math.Abs(got[i]-strided[2*i]) > tol*math.Max(1, math.Abs(got[i])) {
+					t.Errorf("%v: unexpected Dsymv result at %v: got %v, want %v", name, i, got[i], strided[2*i])
+				}
+			}
+
+			// Dgbmv against the general band kernel.
+			ab := randvec(3*n, 1, rnd)
+			copy(got, y)
+			impl.Dgbmv(blas.NoTrans, n, n, 1, 1, 1.5, ab, 3, x, 1, 0.5, got, 1)
+			copy(want, y)
+			Implementation{}.Dscal(n, 0.5, want, 1)
+			dgbmvBand(blas.NoTrans, n, n, 1, 1, 1.5, ab, 3, x, 1, 0, want, 1, 0)
+			if !floats.Same(got, want) {
+				t.Errorf("%v: unexpected Dgbmv result", name)
+			}
+		}
+	}
+}
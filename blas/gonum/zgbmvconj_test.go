@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// TestZgbmvNaive checks Zgbmv against a direct evaluation of the sums over
+// the band, so that the conjugation of the band entries for blas.ConjTrans
+// is verified independently of Zgemv.
+func TestZgbmvNaive(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	randC := func() complex128 { return complex(rnd.NormFloat64(), rnd.NormFloat64()) }
+	for _, dims := range [][2]int{{1, 1}, {3, 4}, {5, 3}, {6, 6}} {
+		m, n := dims[0], dims[1]
+		for _, k := range [][2]int{{0, 0}, {1, 2}, {2, 1}} {
+			kL, kU := k[0], k[1]
+			lda := kL + kU + 1
+			a := make([]complex128, m*lda)
+			for i := range a {
+				a[i] = randC()
+			}
+			// elem returns A[i][j], which is zero outside the band.
+			elem := func(i, j int) complex128 {
+				if j < i-kL || j > i+kU {
+					return 0
+				}
+				return a[i*lda+kL+j-i]
+			}
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+				lenX, lenY := n, m
+				if tA != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				for _, inc := range []struct{ x, y int }{{1, 1}, {-2, 1}, {1, -3}} {
+					name := fmt.Sprintf("m=%v,n=%v,kL=%v,kU=%v,tA=%v,incX=%v,incY=%v", m, n, kL, kU, tA, inc.x, inc.y)
+					alpha, beta := randC(), randC()
+					x := make([]complex128, 1+(lenX-1)*abs(inc.x))
+					for i := range x {
+						x[i] = randC()
+					}
+					y := make([]complex128, 1+(lenY-1)*abs(inc.y))
+					for i := range y {
+						y[i] = randC()
+					}
+
+					want := make([]complex128, len(y))
+					copy(want, y)
+					for i := 0; i < lenY; i++ {
+						var sum complex128
+						for j := 0; j < lenX; j++ {
+							var v complex128
+							switch tA {
+							case blas.NoTrans:
+								v = elem(i, j)
+							case blas.Trans:
+								v = elem(j, i)
+							case blas.ConjTrans:
+								v = cmplx.Conj(elem(j, i))
+							}
+							sum += v * x[vecIndex(j, lenX, inc.x)]
+						}
+						iy := vecIndex(i, lenY, inc.y)
+						want[iy] = alpha*sum + beta*want[iy]
+					}
+
+					impl.Zgbmv(tA, m, n, kL, kU, alpha, a, lda, x, inc.x, beta, y, inc.y)
+					for i := range y {
+						if cmplx.Abs(y[i]-want[i]) > tol*math.Max(1, cmplx.Abs(want[i])) {
+							t.Errorf("%v: unexpected y[%v]: got %v, want %v", name, i, y[i], want[i])
+						}
+					}
+				}
+			}
+		}
+	}
+}
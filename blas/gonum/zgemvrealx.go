@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/c128"
+)
+
+// ZgemvRealX performs one of the matrix-vector operations
+//  y = alpha * A * x + beta * y   if trans = blas.NoTrans
+//  y = alpha * Aᵀ * x + beta * y  if trans = blas.Trans
+//  y = alpha * Aᴴ * x + beta * y  if trans = blas.ConjTrans
+// where alpha and beta are scalars, x is a real vector, y is a complex vector,
+// and A is an m×n dense complex matrix. The requirements on the arguments are
+// the same as for Zgemv.
+//
+// The products of the elements of A with the real elements of x take two real
+// multiplications instead of the four of a complex product, and x is not
+// promoted to a complex vector. For trans = blas.Trans and blas.ConjTrans the
+// real and imaginary parts of the sums are accumulated in two temporary real
+// vectors of length n before alpha is applied, and for blas.ConjTrans the
+// conjugate is taken of each sum rather than of each element of A, which is
+// exact for real x.
+func (Implementation) ZgemvRealX(trans blas.Transpose, m, n int, alpha complex128, a []complex128, lda int, x []float64, incX int, beta complex128, y []complex128, incY int) {
+	switch trans {
+	default:
+		panic(badTranspose)
+	case blas.NoTrans, blas.Trans, blas.ConjTrans:
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	var lenX, lenY int
+	if trans == blas.NoTrans {
+		lenX = n
+		lenY = m
+	} else {
+		lenX = m
+		lenY = n
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if alpha == 0 && beta == 1 {
+		return
+	}
+
+	var kx int
+	if incX < 0 {
+		kx = (1 - lenX) * incX
+	}
+	var ky int
+	if incY < 0 {
+		ky = (1 - lenY) * incY
+	}
+
+	// Form y = beta*y.
+	if beta != 1 {
+		iy := ky
+		if beta == 0 {
+			for i := 0; i < lenY; i++ {
+				y[iy] = 0
+				iy += incY
+			}
+		} else {
+			if incY > 0 {
+				c128.ScalInc(beta, y, uintptr(lenY), uintptr(incY))
+			} else {
+				c128.ScalInc(beta, y, uintptr(lenY), uintptr(-incY))
+			}
+		}
+	}
+
+	if alpha == 0 {
+		return
+	}
+
+	if trans == blas.NoTrans {
+		// Form y = alpha*A*x + y.
+		iy := ky
+		for i := 0; i < m; i++ {
+			var re, im float64
+			jx := kx
+			for _, v := range a[i*lda : i*lda+n] {
+				re += real(v) * x[jx]
+				im += imag(v) * x[jx]
+				jx += incX
+			}
+			y[iy] += alpha * complex(re, im)
+			iy += incY
+		}
+		return
+	}
+
+	// Form t = Aᵀ*x in the real and imaginary parts of A separately.
+	re := make([]float64, n)
+	im := make([]float64, n)
+	ix := kx
+	for i := 0; i < m; i++ {
+		xi := x[ix]
+		for j, v := range a[i*lda : i*lda+n] {
+			re[j] += real(v) * xi
+			im[j] += imag(v) * xi
+		}
+		ix += incX
+	}
+
+	// Form y = alpha*t + y, or y = alpha*conj(t) + y since Aᴴ*x = conj(Aᵀ*x)
+	// for real x.
+	iy := ky
+	for j := 0; j < n; j++ {
+		t := complex(re[j], im[j])
+		if trans == blas.ConjTrans {
+			t = cmplx.Conj(t)
+		}
+		y[iy] += alpha * t
+		iy += incY
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestZgemvRealX(t *testing.T) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	randC := func() complex128 { return complex(rnd.NormFloat64(), rnd.NormFloat64()) }
+	for _, dims := range [][2]int{{1, 1}, {3, 4}, {5, 3}, {10, 10}} {
+		m, n := dims[0], dims[1]
+		lda := n + 2
+		a := make([]complex128, m*lda)
+		for i := range a {
+			a[i] = randC()
+		}
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			for _, inc := range []struct{ x, y int }{{1, 1}, {-2, 1}, {1, -3}} {
+				for _, ab := range []struct{ alpha, beta complex128 }{{randC(), randC()}, {randC(), 0}, {0, randC()}, {1, 1}} {
+					name := fmt.Sprintf("m=%v,n=%v,tA=%v,incX=%v,incY=%v,alpha=%v,beta=%v", m, n, tA, inc.x, inc.y, ab.alpha, ab.beta)
+					x := randvec(lenX, inc.x, rnd)
+					y := make([]complex128, 1+(lenY-1)*abs(inc.y))
+					for i := range y {
+						y[i] = randC()
+					}
+
+					// Promote x to a complex vector and use Zgemv.
+					xc := make([]complex128, len(x))
+					for i, v := range x {
+						xc[i] = complex(v, 0)
+					}
+					want := make([]complex128, len(y))
+					copy(want, y)
+					impl.Zgemv(tA, m, n, ab.alpha, a, lda, xc, inc.x, ab.beta, want, inc.y)
+
+					impl.ZgemvRealX(tA, m, n, ab.alpha, a, lda, x, inc.x, ab.beta, y, inc.y)
+					for i := range y {
+						if cmplx.Abs(y[i]-want[i]) > tol {
+							t.Errorf("%s: unexpected y[%d]: got %v, want %v", name, i, y[i], want[i])
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -5,9 +5,13 @@
 package testblas
 
 import (
+	"fmt"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
 )
 
 type Dgbmver interface {
@@ -150,4 +154,77 @@ func DgbmvTest(t *testing.T, blasser Dgbmver) {
 		incTest(3, 2, extra)
 		incTest(-3, 2, extra)
 	}
+
+	// Check bands up to and beyond the width of the matrix, including
+	// full triangles stored in band format, against a dense product.
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, mn := range [][2]int{{1, 1}, {1, 4}, {4, 1}, {3, 5}, {5, 3}, {6, 6}} {
+			m, n := mn[0], mn[1]
+			for _, kLU := range [][2]int{{m - 1, 0}, {0, n - 1}, {m - 1, n - 1}, {m, n}, {m + 2, 1}, {1, n + 2}} {
+				for _, inc := range [][2]int{{1, 1}, {2, -3}, {-1, 2}} {
+					testDgbmvDense(t, blasser, rnd, tA, m, n, kLU[0], kLU[1], inc[0], inc[1])
+				}
+			}
+		}
+	}
+}
+
+// testDgbmvDense checks Dgbmv against a naive dense matrix-vector product
+// with a random band matrix.
+func testDgbmvDense(t *testing.T, blasser Dgbmver, rnd *rand.Rand, tA blas.Transpose, m, n, kL, kU, incX, incY int) {
+	const tol = 1e-14
+
+	a := make([][]float64, m)
+	for i := range a {
+		a[i] = make([]float64, n)
+		for j := max(0, i-kL); j < min(n, i+kU+1); j++ {
+			a[i][j] = rnd.NormFloat64()
+		}
+	}
+	lenX, lenY := n, m
+	if tA != blas.NoTrans {
+		lenX, lenY = m, n
+	}
+	x := make([]float64, lenX)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	y := make([]float64, lenY)
+	for i := range y {
+		y[i] = rnd.NormFloat64()
+	}
+	alpha, beta := 1.5, -0.5
+
+	want := make([]float64, lenY)
+	for i := range want {
+		var sum float64
+		for j := range x {
+			if tA == blas.NoTrans {
+				sum += a[i][j] * x[j]
+			} else {
+				sum += a[j][i] * x[j]
+			}
+		}
+		want[i] = alpha*sum + beta*y[i]
+	}
+
+	const extra = 2
+	lda := kL + kU + 1 + extra
+	aFlat := make([]float64, m*lda)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kL); j < min(n, i+kU+1); j++ {
+			aFlat[i*lda+j-i+kL] = a[i][j]
+		}
+	}
+	xInc := makeIncremented(x, incX, extra)
+	yInc := makeIncremented(y, incY, extra)
+	wantInc := makeIncremented(want, incY, extra)
+
+	blasser.Dgbmv(tA, m, n, kL, kU, alpha, aFlat, lda, xInc, incX, beta, yInc, incY)
+
+	name := fmt.Sprintf("tA=%v,m=%v,n=%v,kL=%v,kU=%v,incX=%v,incY=%v", tA, m, n, kL, kU, incX, incY)
+	if !floats.EqualApprox(yInc, wantInc, tol) {
+		t.Errorf("%v: unexpected result\nwant %v\ngot  %v", name, wantInc, yInc)
+	}
 }
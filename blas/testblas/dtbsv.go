@@ -7,6 +7,8 @@ package testblas
 import (
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas"
 )
 
@@ -212,6 +214,58 @@ func DtbsvTest(t *testing.T, blasser Dtbsver) {
 		}
 	}
 
+	// Compare with a dense solve for small matrices where the band is
+	// truncated by the top-left and bottom-right corners of the matrix,
+	// that is, where i < k or i+k >= n for some rows i.
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 4} {
+					for _, k := range []int{0, 1, n - 1, n, n + 1} {
+						if k < 0 {
+							continue
+						}
+						for _, incX := range []int{1, 2, -3} {
+							a := make([][]float64, n)
+							for i := range a {
+								a[i] = make([]float64, n)
+								for j := range a[i] {
+									if (ul == blas.Upper && j >= i && j-i <= k) || (ul == blas.Lower && j <= i && i-j <= k) {
+										a[i][j] = rnd.NormFloat64()
+									}
+								}
+								a[i][i] = 2 + rnd.Float64()
+							}
+							x := make([]float64, n)
+							for i := range x {
+								x[i] = rnd.NormFloat64()
+							}
+							xInc := makeIncremented(x, incX, 2)
+
+							var aBand []float64
+							if ul == blas.Upper {
+								aBand = flattenBanded(a, k, 0)
+							} else {
+								aBand = flattenBanded(a, 0, k)
+							}
+							got := sliceCopy(xInc)
+							blasser.Dtbsv(ul, tA, d, n, k, aBand, k+1, got, incX)
+
+							want := sliceCopy(xInc)
+							blasser.Dtrsv(ul, tA, d, n, flatten(a), n, want, incX)
+
+							if !dSliceTolEqual(want, got) {
+								t.Errorf("ul=%v,tA=%v,d=%v,n=%v,k=%v,incX=%v: band and dense solve mismatch\nwant %v\ngot  %v",
+									ul, tA, d, n, k, incX, want, got)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
 	/*
 		// TODO: Uncomment when Dtrsv is fixed
 		// Compare with dense for larger matrices
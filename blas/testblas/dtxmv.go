@@ -5,8 +5,12 @@
 package testblas
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas"
 )
 
@@ -147,3 +151,82 @@ func DtxmvTest(t *testing.T, blasser Dtxmver) {
 		}
 	}
 }
+
+// DtxmvAgreementTest checks that Dtrmv, Dtbmv and Dtpmv agree on random
+// triangular matrices stored in the dense, band and packed formats. The
+// elements outside the stored triangle, and the diagonal for blas.Unit, are
+// set to NaN in every format so that any access to them is detected. The
+// routines accumulate the products in different orders, so the results are
+// compared with a tolerance.
+func DtxmvAgreementTest(t *testing.T, blasser Dtxmver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{1, 2, 3, 5, 8} {
+					for _, k := range []int{0, 1, 2, n - 1, n} {
+						if k < 0 {
+							continue
+						}
+						// a is an n×n triangular matrix with k+1 diagonals.
+						// The zero elements in the triangle outside the band
+						// are stored explicitly in the dense and packed
+						// formats.
+						a := make([][]float64, n)
+						for i := range a {
+							a[i] = make([]float64, n)
+							for j := range a[i] {
+								switch {
+								case (ul == blas.Upper && j < i) || (ul == blas.Lower && j > i):
+									a[i][j] = math.NaN()
+								case i == j && d == blas.Unit:
+									a[i][j] = math.NaN()
+								case (ul == blas.Upper && j-i <= k) || (ul == blas.Lower && i-j <= k):
+									a[i][j] = rnd.NormFloat64()
+								}
+							}
+						}
+						aDense := flatten(a)
+						aPacked := flattenTriangular(a, ul)
+						var aBand []float64
+						if ul == blas.Upper {
+							aBand = flattenBanded(a, k, 0)
+						} else {
+							aBand = flattenBanded(a, 0, k)
+						}
+
+						x := make([]float64, n)
+						for i := range x {
+							x[i] = rnd.NormFloat64()
+						}
+						for _, incX := range []int{1, 2, -3} {
+							name := fmt.Sprintf("ul=%v,tA=%v,d=%v,n=%v,k=%v,incX=%v", uploString(ul), transString(tA), diagString(d), n, k, incX)
+							xInc := makeIncremented(x, incX, 2)
+
+							want := sliceCopy(xInc)
+							blasser.Dtrmv(ul, tA, d, n, aDense, n, want, incX)
+							for _, v := range want {
+								if math.IsNaN(v) {
+									t.Errorf("%v: Dtrmv accessed an element outside the triangle", name)
+									break
+								}
+							}
+
+							gotBand := sliceCopy(xInc)
+							blasser.Dtbmv(ul, tA, d, n, k, aBand, k+1, gotBand, incX)
+							if !dSliceTolEqual(want, gotBand) {
+								t.Errorf("%v: Dtbmv differs from Dtrmv\nwant %v\ngot  %v", name, want, gotBand)
+							}
+
+							gotPacked := sliceCopy(xInc)
+							blasser.Dtpmv(ul, tA, d, n, aPacked, gotPacked, incX)
+							if !dSliceTolEqual(want, gotPacked) {
+								t.Errorf("%v: Dtpmv differs from Dtrmv\nwant %v\ngot  %v", name, want, gotPacked)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testblas
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// level2BenchData holds the randomly generated operands of a Level 2
+// routine benchmark.
+type level2BenchData struct {
+	a    []float64
+	x, y []float64
+}
+
+// newLevel2BenchData returns operands for a Level 2 routine benchmark with a
+// matrix of the given number of rows and leading dimension lda, an x vector
+// of lenX elements with increment incX and a y vector of lenY elements with
+// increment incY. The elements between vector elements are also set.
+func newLevel2BenchData(rows, lda, lenX, incX, lenY, incY int) level2BenchData {
+	rnd := rand.New(rand.NewSource(1))
+	fill := func(n int) []float64 {
+		v := make([]float64, n)
+		for i := range v {
+			v[i] = rnd.Float64()
+		}
+		return v
+	}
+	return level2BenchData{
+		a: fill(rows * lda),
+		x: fill(1 + (lenX-1)*abs(incX)),
+		y: fill(1 + (lenY-1)*abs(incY)),
+	}
+}
+
+// runLevel2Benchmark runs f b.N times and reports the achieved
+// floating-point rate for a routine call performing flops floating-point
+// operations.
+func runLevel2Benchmark(b *testing.B, flops float64, f func()) {
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+	elapsed := time.Since(start)
+	b.StopTimer()
+	if s := elapsed.Seconds(); s > 0 {
+		b.ReportMetric(flops*float64(b.N)/s/1e9, "GFLOP/s")
+	}
+}
+
+// DsymvBenchmark benchmarks Dsymv with an n×n matrix and reports the
+// floating-point rate.
+func DsymvBenchmark(b *testing.B, impl Dsymver, ul blas.Uplo, n, incX, incY int) {
+	d := newLevel2BenchData(n, n, n, incX, n, incY)
+	// beta is 1 so that y, which is updated on every iteration, grows only
+	// linearly with b.N and does not overflow.
+	runLevel2Benchmark(b, 2*float64(n)*float64(n), func() {
+		impl.Dsymv(ul, n, 2, d.a, n, d.x, incX, 1, d.y, incY)
+	})
+}
+
+// DsbmvBenchmark benchmarks Dsbmv with an n×n matrix with k off-diagonals
+// and reports the floating-point rate.
+func DsbmvBenchmark(b *testing.B, impl Dsbmver, ul blas.Uplo, n, k, incX, incY int) {
+	lda := k + 1
+	d := newLevel2BenchData(n, lda, n, incX, n, incY)
+	// Each of the n(2k+1) - k(k+1) stored and mirrored elements of the
+	// band contributes a multiply and an add.
+	nnz := float64(n*(2*k+1) - k*(k+1))
+	runLevel2Benchmark(b, 2*nnz, func() {
+		impl.Dsbmv(ul, n, k, 2, d.a, lda, d.x, incX, 1, d.y, incY)
+	})
+}
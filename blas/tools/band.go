@@ -0,0 +1,142 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tools
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// BandConvention specifies how a triangular band matrix is laid out in
+// compact band storage.
+type BandConvention int
+
+const (
+	// RowBand is the convention expected by Dtbmv and Dtbsv. Row i of the
+	// compact storage holds the band elements of row i of A, so the
+	// diagonal is in column 0 for an upper triangular matrix and in
+	// column k for a lower triangular matrix.
+	RowBand BandConvention = iota
+	// ColumnBand is the column-major band storage used by the reference
+	// Fortran BLAS and LAPACK, indexed as if it were row-major. Row j of
+	// the compact storage holds the band elements of column j of A, so the
+	// diagonal is in column k for an upper triangular matrix and in
+	// column 0 for a lower triangular matrix.
+	ColumnBand
+)
+
+func (c BandConvention) String() string {
+	switch c {
+	case RowBand:
+		return "RowBand"
+	case ColumnBand:
+		return "ColumnBand"
+	}
+	return fmt.Sprintf("BandConvention(%d)", int(c))
+}
+
+// BandDiagnosis is the result of interpreting a compact band matrix under
+// one storage convention.
+type BandDiagnosis struct {
+	Convention BandConvention
+
+	// Valid is true if all the diagonal elements are non-zero and all the
+	// band elements are finite when the storage is read under Convention.
+	Valid bool
+
+	// Reason describes the first problem found if Valid is false.
+	Reason string
+}
+
+// DiagnoseTriBand interprets the n×n triangular band matrix with k
+// super- or sub-diagonals stored in ab with leading dimension ldab under
+// each of RowBand and ColumnBand, and returns a diagnosis for each, in that
+// order. A correctly laid out non-singular matrix with zero or NaN padding is
+// Valid only under the convention it was stored with, so a RowBand result
+// that is not Valid together with a Valid ColumnBand result indicates storage
+// that Dtbmv and Dtbsv will misread.
+//
+// The diagnosis is a heuristic. For k = 0 the two conventions are identical,
+// and a matrix whose padding holds finite non-zero values may be Valid under
+// both.
+func DiagnoseTriBand(ul blas.Uplo, n, k int, ab []float64, ldab int) []BandDiagnosis {
+	var diags []BandDiagnosis
+	for _, c := range []BandConvention{RowBand, ColumnBand} {
+		diag := BandDiagnosis{Convention: c, Valid: true}
+		a := ExpandTriBand(c, ul, n, k, ab, ldab)
+	check:
+		for i := 0; i < n; i++ {
+			lo, hi := i, min(n, i+k+1)
+			if ul == blas.Lower {
+				lo, hi = max(0, i-k), i+1
+			}
+			for j := lo; j < hi; j++ {
+				v := a[i*n+j]
+				switch {
+				case math.IsNaN(v) || math.IsInf(v, 0):
+					diag.Valid = false
+					diag.Reason = fmt.Sprintf("element (%d, %d) is %v", i, j, v)
+					break check
+				case i == j && v == 0:
+					diag.Valid = false
+					diag.Reason = fmt.Sprintf("diagonal element (%d, %d) is zero", i, j)
+					break check
+				}
+			}
+		}
+		diags = append(diags, diag)
+	}
+	return diags
+}
+
+// ExpandTriBand returns the n×n triangular band matrix with k super- or
+// sub-diagonals stored in ab with leading dimension ldab under the
+// convention c, as a dense row-major slice with leading dimension n. The
+// elements outside the band are zero.
+func ExpandTriBand(c BandConvention, ul blas.Uplo, n, k int, ab []float64, ldab int) []float64 {
+	if c != RowBand && c != ColumnBand {
+		panic("tools: bad band convention")
+	}
+	if ul != blas.Upper && ul != blas.Lower {
+		panic("tools: bad uplo")
+	}
+	if n < 0 || k < 0 {
+		panic(errBadSize)
+	}
+	if ldab < k+1 {
+		panic(errBadLd)
+	}
+	if n > 0 && len(ab) < ldab*(n-1)+k+1 {
+		panic(errShortA)
+	}
+
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		// Row i of ab holds row i of A for RowBand and column i for
+		// ColumnBand.
+		for j := max(0, i-k); j < min(n, i+k+1); j++ {
+			switch {
+			case c == RowBand && ul == blas.Upper && j >= i:
+				a[i*n+j] = ab[i*ldab+j-i]
+			case c == RowBand && ul == blas.Lower && j <= i:
+				a[i*n+j] = ab[i*ldab+k+j-i]
+			case c == ColumnBand && ul == blas.Upper && j <= i:
+				a[j*n+i] = ab[i*ldab+k+j-i]
+			case c == ColumnBand && ul == blas.Lower && j >= i:
+				a[j*n+i] = ab[i*ldab+j-i]
+			}
+		}
+	}
+	return a
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tools
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDiagnoseTriBand(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 5, 8} {
+			for _, k := range []int{1, 2, 4, 9} {
+				for _, pad := range []float64{0, math.NaN()} {
+					a := randTriBand(rnd, ul, n, k)
+					for _, c := range []BandConvention{RowBand, ColumnBand} {
+						name := fmt.Sprintf("ul=%c,n=%v,k=%v,pad=%v,stored=%v", ul, n, k, pad, c)
+						ldab := k + 2
+						ab := packTriBand(c, ul, n, k, a, ldab, pad)
+
+						got := ExpandTriBand(c, ul, n, k, ab, ldab)
+						if !floats.Equal(got, a) {
+							t.Errorf("%v: unexpected expansion:\ngot  %v\nwant %v", name, got, a)
+						}
+
+						diags := DiagnoseTriBand(ul, n, k, ab, ldab)
+						if len(diags) != 2 {
+							t.Fatalf("%v: unexpected number of diagnoses: %v", name, len(diags))
+						}
+						for _, d := range diags {
+							if d.Convention == c && !d.Valid {
+								t.Errorf("%v: storage convention not valid: %v", name, d.Reason)
+							}
+							if d.Convention != c && d.Valid {
+								t.Errorf("%v: unexpected valid diagnosis for %v", name, d.Convention)
+							}
+							if d.Valid != (d.Reason == "") {
+								t.Errorf("%v: mismatched Valid=%v and Reason=%q for %v", name, d.Valid, d.Reason, d.Convention)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDiagnoseTriBandDiagonal(t *testing.T) {
+	// A zero on the diagonal is reported under both conventions when k = 0.
+	ab := []float64{1, 0, 3}
+	for _, d := range DiagnoseTriBand(blas.Upper, 3, 0, ab, 1) {
+		if d.Valid {
+			t.Errorf("unexpected valid diagnosis for %v with zero diagonal", d.Convention)
+		}
+	}
+	ab[1] = 2
+	for _, d := range DiagnoseTriBand(blas.Lower, 3, 0, ab, 1) {
+		if !d.Valid {
+			t.Errorf("unexpected invalid diagnosis for %v of a diagonal matrix: %v", d.Convention, d.Reason)
+		}
+	}
+}
+
+// TestRowBandDtbmv checks that RowBand is the convention used by Dtbmv.
+func TestRowBandDtbmv(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	impl := gonum.Implementation{}
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 3, 6} {
+			for _, k := range []int{0, 1, 2, 7} {
+				a := randTriBand(rnd, ul, n, k)
+				ab := packTriBand(RowBand, ul, n, k, a, k+1, math.NaN())
+				x := make([]float64, n)
+				for i := range x {
+					x[i] = rnd.NormFloat64()
+				}
+				want := make([]float64, n)
+				impl.Dgemv(blas.NoTrans, n, n, 1, a, n, x, 1, 0, want, 1)
+				impl.Dtbmv(ul, blas.NoTrans, blas.NonUnit, n, k, ab, k+1, x, 1)
+				if !floats.EqualApprox(x, want, tol) {
+					t.Errorf("ul=%c,n=%v,k=%v: unexpected Dtbmv result:\ngot  %v\nwant %v", ul, n, k, x, want)
+				}
+			}
+		}
+	}
+}
+
+// randTriBand returns a random dense n×n triangular band matrix with k
+// super- or sub-diagonals and a diagonal away from zero.
+func randTriBand(rnd *rand.Rand, ul blas.Uplo, n, k int) []float64 {
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (ul == blas.Upper && (j < i || j > i+k)) || (ul == blas.Lower && (j > i || j < i-k)) {
+				continue
+			}
+			a[i*n+j] = rnd.NormFloat64()
+			if i == j {
+				a[i*n+j] = 1 + rnd.Float64()
+			}
+		}
+	}
+	return a
+}
+
+// packTriBand returns the compact band storage with leading dimension ldab
+// of the dense n×n triangular band matrix a under the convention c. Unused
+// elements of the storage are set to pad.
+func packTriBand(c BandConvention, ul blas.Uplo, n, k int, a []float64, ldab int, pad float64) []float64 {
+	ab := make([]float64, n*ldab)
+	for i := range ab {
+		ab[i] = pad
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var r, col int
+			switch {
+			case ul == blas.Upper && j >= i && j <= i+k:
+				if c == RowBand {
+					r, col = i, j-i
+				} else {
+					r, col = j, k+i-j
+				}
+			case ul == blas.Lower && j <= i && j >= i-k:
+				if c == RowBand {
+					r, col = i, k+j-i
+				} else {
+					r, col = j, i-j
+				}
+			default:
+				continue
+			}
+			ab[r*ldab+col] = a[i*n+j]
+		}
+	}
+	return ab
+}
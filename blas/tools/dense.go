@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tools
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// maxLen is the biggest slice len one can create on a 32/64b platform.
+const maxLen = int64(int(^uint(0) >> 1))
+
+var (
+	errBadSize = errors.New("tools: invalid dimension")
+	errBadLd   = errors.New("tools: invalid leading dimension")
+	errShortA  = errors.New("tools: insufficient matrix slice length")
+	errTooBig  = errors.New("tools: resulting data slice too big")
+)
+
+// WriteDense writes the m×n row-major matrix stored in a with leading
+// dimension lda to w and returns the number of bytes written and an error,
+// if any.
+//
+// The data are little-endian encoded, independently of the byte order of
+// the host, as follows:
+//   0 -  7  number of rows, m       (int64)
+//   8 - 15  number of columns, n    (int64)
+//  16 - 23  leading dimension, lda  (int64)
+//  24 - ..  a[:(m-1)*lda+n]         (float64)
+// The padding elements between rows are written as they are, so that a
+// matrix that triggers a bug through its padding is reproduced exactly.
+// No data elements are written if m or n is zero.
+func WriteDense(w io.Writer, m, n int, a []float64, lda int) (int, error) {
+	if m < 0 || n < 0 {
+		return 0, errBadSize
+	}
+	if lda < max(1, n) {
+		return 0, errBadLd
+	}
+	size := denseLen(m, n, lda)
+	if len(a) < size {
+		return 0, errShortA
+	}
+
+	var buf [24]byte
+	binary.LittleEndian.PutUint64(buf[0:8], uint64(m))
+	binary.LittleEndian.PutUint64(buf[8:16], uint64(n))
+	binary.LittleEndian.PutUint64(buf[16:24], uint64(lda))
+	nw, err := w.Write(buf[:])
+	if err != nil {
+		return nw, err
+	}
+	for _, v := range a[:size] {
+		binary.LittleEndian.PutUint64(buf[:8], math.Float64bits(v))
+		nn, err := w.Write(buf[:8])
+		nw += nn
+		if err != nil {
+			return nw, err
+		}
+	}
+	return nw, nil
+}
+
+// ReadDense reads a matrix written by WriteDense from r. It returns the
+// dimensions m and n, the matrix slice a of length (m-1)*lda+n, or zero if m
+// or n is zero, the leading dimension lda and an error, if any.
+//
+// ReadDense does not limit the size of the matrix it reads, and so it should
+// not be used on untrusted data.
+func ReadDense(r io.Reader) (m, n int, a []float64, lda int, err error) {
+	var buf [24]byte
+	_, err = io.ReadFull(r, buf[:])
+	if err != nil {
+		return 0, 0, nil, 0, err
+	}
+	rows := int64(binary.LittleEndian.Uint64(buf[0:8]))
+	cols := int64(binary.LittleEndian.Uint64(buf[8:16]))
+	ld := int64(binary.LittleEndian.Uint64(buf[16:24]))
+	if rows < 0 || cols < 0 || rows > maxLen || cols > maxLen {
+		return 0, 0, nil, 0, errBadSize
+	}
+	if ld < 1 || ld < cols || ld > maxLen {
+		return 0, 0, nil, 0, errBadLd
+	}
+	if rows > 1 && (rows-1) > (maxLen-cols)/ld {
+		return 0, 0, nil, 0, errTooBig
+	}
+	m, n, lda = int(rows), int(cols), int(ld)
+
+	a = make([]float64, denseLen(m, n, lda))
+	for i := range a {
+		_, err = io.ReadFull(r, buf[:8])
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, 0, nil, 0, err
+		}
+		a[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[:8]))
+	}
+	return m, n, a, lda, nil
+}
+
+// denseLen returns the length of the slice holding an m×n matrix with
+// leading dimension lda.
+func denseLen(m, n, lda int) int {
+	if m == 0 || n == 0 {
+		return 0
+	}
+	return (m-1)*lda + n
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
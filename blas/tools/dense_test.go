@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tools
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDenseRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{0, 0, 1},
+		{0, 3, 3},
+		{3, 0, 1},
+		{1, 1, 1},
+		{1, 4, 6},
+		{4, 1, 1},
+		{3, 5, 5},
+		{5, 3, 7},
+	} {
+		m, n, lda := test.m, test.n, test.lda
+		name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+		a := make([]float64, denseLen(m, n, lda))
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+		if len(a) > 1 {
+			// Special values must survive the round trip bit for bit.
+			a[0] = math.NaN()
+			a[len(a)-1] = math.Copysign(0, -1)
+		}
+
+		var buf bytes.Buffer
+		nw, err := WriteDense(&buf, m, n, a, lda)
+		if err != nil {
+			t.Fatalf("%v: unexpected write error: %v", name, err)
+		}
+		if wantLen := 24 + 8*len(a); nw != wantLen || buf.Len() != wantLen {
+			t.Errorf("%v: unexpected number of bytes written: got %v (buffer %v), want %v", name, nw, buf.Len(), wantLen)
+		}
+
+		gm, gn, ga, glda, err := ReadDense(&buf)
+		if err != nil {
+			t.Fatalf("%v: unexpected read error: %v", name, err)
+		}
+		if gm != m || gn != n || glda != lda {
+			t.Errorf("%v: unexpected dimensions: got m=%v,n=%v,lda=%v", name, gm, gn, glda)
+		}
+		if len(ga) != len(a) {
+			t.Fatalf("%v: unexpected slice length: got %v, want %v", name, len(ga), len(a))
+		}
+		for i := range a {
+			if math.Float64bits(ga[i]) != math.Float64bits(a[i]) {
+				t.Errorf("%v: unexpected element %v: got %v, want %v", name, i, ga[i], a[i])
+			}
+		}
+		if buf.Len() != 0 {
+			t.Errorf("%v: %v bytes left unread", name, buf.Len())
+		}
+	}
+}
+
+func TestDenseByteOrder(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := WriteDense(&buf, 2, 1, []float64{1, -1, 2}, 2)
+	if err != nil {
+		t.Fatalf("unexpected write error: %v", err)
+	}
+	want := make([]byte, 24+3*8)
+	binary.LittleEndian.PutUint64(want[0:], 2)
+	binary.LittleEndian.PutUint64(want[8:], 1)
+	binary.LittleEndian.PutUint64(want[16:], 2)
+	binary.LittleEndian.PutUint64(want[24:], math.Float64bits(1))
+	binary.LittleEndian.PutUint64(want[32:], math.Float64bits(-1))
+	binary.LittleEndian.PutUint64(want[40:], math.Float64bits(2))
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("unexpected encoding:\ngot  %v\nwant %v", buf.Bytes(), want)
+	}
+}
+
+func TestWriteDenseErrors(t *testing.T) {
+	for _, test := range []struct {
+		m, n, lda int
+		a         []float64
+		want      error
+	}{
+		{m: -1, n: 1, lda: 1, want: errBadSize},
+		{m: 1, n: -1, lda: 1, want: errBadSize},
+		{m: 1, n: 3, lda: 2, a: make([]float64, 3), want: errBadLd},
+		{m: 1, n: 0, lda: 0, want: errBadLd},
+		{m: 2, n: 3, lda: 4, a: make([]float64, 6), want: errShortA},
+	} {
+		var buf bytes.Buffer
+		_, err := WriteDense(&buf, test.m, test.n, test.a, test.lda)
+		if err != test.want {
+			t.Errorf("m=%v,n=%v,lda=%v: unexpected error: got %v, want %v", test.m, test.n, test.lda, err, test.want)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("m=%v,n=%v,lda=%v: unexpected data written on error", test.m, test.n, test.lda)
+		}
+	}
+}
+
+func TestReadDenseErrors(t *testing.T) {
+	header := func(m, n, lda int64) []byte {
+		b := make([]byte, 24)
+		binary.LittleEndian.PutUint64(b[0:], uint64(m))
+		binary.LittleEndian.PutUint64(b[8:], uint64(n))
+		binary.LittleEndian.PutUint64(b[16:], uint64(lda))
+		return b
+	}
+	for _, test := range []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{name: "empty", data: nil, want: io.EOF},
+		{name: "short header", data: header(1, 1, 1)[:20], want: io.ErrUnexpectedEOF},
+		{name: "negative rows", data: header(-1, 1, 1), want: errBadSize},
+		{name: "negative columns", data: header(1, -1, 1), want: errBadSize},
+		{name: "zero lda", data: header(0, 0, 0), want: errBadLd},
+		{name: "small lda", data: header(2, 3, 2), want: errBadLd},
+		{name: "too big", data: header(math.MaxInt64, 2, 2), want: errTooBig},
+		{name: "no data", data: header(2, 2, 3), want: io.ErrUnexpectedEOF},
+		{name: "short data", data: append(header(1, 2, 2), make([]byte, 12)...), want: io.ErrUnexpectedEOF},
+	} {
+		_, _, a, _, err := ReadDense(bytes.NewReader(test.data))
+		if err != test.want {
+			t.Errorf("%v: unexpected error: got %v, want %v", test.name, err, test.want)
+		}
+		if a != nil {
+			t.Errorf("%v: unexpected non-nil slice on error", test.name)
+		}
+	}
+}
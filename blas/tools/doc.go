@@ -0,0 +1,8 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tools provides helpers for tests and tools that work with BLAS
+// matrix arguments, such as reading and writing matrix data attached to bug
+// reports.
+package tools // import "gonum.org/v1/gonum/blas/tools"
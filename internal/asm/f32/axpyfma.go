@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32
+
+import "math"
+
+// AxpyUnitaryFMA is
+//  for i, v := range x {
+//  	y[i] = fma32(alpha, v, y[i])
+//  }
+func AxpyUnitaryFMA(alpha float32, x, y []float32) {
+	for i, v := range x {
+		y[i] = fma32(alpha, v, y[i])
+	}
+}
+
+// AxpyIncFMA is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] = fma32(alpha, x[ix], y[iy])
+//  	ix += incX
+//  	iy += incY
+//  }
+func AxpyIncFMA(alpha float32, x, y []float32, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] = fma32(alpha, x[ix], y[iy])
+		ix += incX
+		iy += incY
+	}
+}
+
+// fma32 returns a*b + c computed with a single rounding to float32.
+//
+// The product of two float32 values is exact in float64, but rounding the
+// float64 sum and then rounding again to float32 may give a result that
+// differs from the correctly rounded one when the first rounding lands on a
+// float32 midpoint. Instead the sum is rounded to odd, that is, an inexact sum
+// is replaced by the neighbouring float64 with an odd significand, which
+// rounds correctly to float32 since float64 has more than twice the
+// precision.
+func fma32(a, b, c float32) float32 {
+	p := float64(a) * float64(b)
+	s := p + float64(c)
+
+	// p + c == s + e exactly.
+	t := s - p
+	e := (p - (s - t)) + (float64(c) - t)
+
+	// s-s is zero unless s is NaN or infinite.
+	if e != 0 && s-s == 0 {
+		bits := math.Float64bits(s)
+		if bits&1 == 0 {
+			// Move s one ulp toward the exact sum.
+			if (e > 0) == (s > 0) {
+				bits++
+			} else {
+				bits--
+			}
+			s = math.Float64frombits(bits)
+		}
+	}
+	return float32(s)
+}
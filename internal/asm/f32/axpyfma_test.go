@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32_test
+
+import (
+	"testing"
+
+	. "gonum.org/v1/gonum/internal/asm/f32"
+)
+
+func TestAxpyUnitaryFMA(t *testing.T) {
+	for j, v := range tests {
+		gdLn := 4 + j%2
+		v.x, v.y = guardVector(v.x, 1, gdLn), guardVector(v.y, 1, gdLn)
+		x, y := v.x[gdLn:len(v.x)-gdLn], v.y[gdLn:len(v.y)-gdLn]
+		AxpyUnitaryFMA(v.a, x, y)
+		for i := range v.ex {
+			if !same(y[i], v.ex[i]) {
+				t.Error("Test", j, "Unexpected result at", i, "Got:", y[i], "Expected:", v.ex[i])
+			}
+		}
+		if !isValidGuard(v.x, 1, gdLn) {
+			t.Error("Test", j, "Guard violated in x vector", v.x[:gdLn], v.x[len(v.x)-gdLn:])
+		}
+		if !isValidGuard(v.y, 1, gdLn) {
+			t.Error("Test", j, "Guard violated in y vector", v.y[:gdLn], v.y[len(v.x)-gdLn:])
+		}
+	}
+}
+
+func TestAxpyIncFMA(t *testing.T) {
+	for j, v := range tests {
+		gdLn := 4 + j%2
+		v.x, v.y = guardIncVector(v.x, 1, int(v.incX), gdLn), guardIncVector(v.y, 1, int(v.incY), gdLn)
+		x, y := v.x[gdLn:len(v.x)-gdLn], v.y[gdLn:len(v.y)-gdLn]
+		AxpyIncFMA(v.a, x, y, uintptr(len(v.ex)), v.incX, v.incY, v.ix, v.iy)
+		for i := range v.ex {
+			if !same(y[i*int(v.incY)], v.ex[i]) {
+				t.Error("Test", j, "Unexpected result at", i, "Got:", y[i*int(v.incY)], "Expected:", v.ex[i])
+			}
+		}
+		checkValidIncGuard(t, v.x, 1, int(v.incX), gdLn)
+		checkValidIncGuard(t, v.y, 1, int(v.incY), gdLn)
+	}
+}
+
+func TestAxpyFMAAccuracy(t *testing.T) {
+	// alpha*x = 1 - 2⁻³⁰ exactly, which rounds to 1 in float32, so the
+	// unfused update loses the entire result while the fused update is exact.
+	const (
+		alpha = 1 + 0x1p-15
+		xv    = 1 - 0x1p-15
+		want  = -0x1p-30
+	)
+	a, b := float32(alpha), float32(xv)
+	if unfused := float32(a*b) - 1; unfused == want {
+		t.Fatalf("unfused update unexpectedly exact")
+	}
+
+	y := []float32{-1, -1}
+	AxpyUnitaryFMA(alpha, []float32{xv, xv}, y)
+	for i, v := range y {
+		if v != want {
+			t.Errorf("unexpected AxpyUnitaryFMA result at %v: got %v, want %v", i, v, want)
+		}
+	}
+
+	y = []float32{-1, -1}
+	AxpyIncFMA(alpha, []float32{xv, xv}, y, 2, 1, 1, 0, 0)
+	for i, v := range y {
+		if v != want {
+			t.Errorf("unexpected AxpyIncFMA result at %v: got %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestAxpyFMARounding(t *testing.T) {
+	// alpha*x + y = 1 + 2⁻²³ + 2⁻²⁴ - 2⁻⁷⁰ exactly, which is just below the
+	// midpoint between 1 + 2⁻²³ and 1 + 2⁻²², so it rounds down to 1 + 2⁻²³.
+	// Rounding to float64 first gives the midpoint, which then rounds to even
+	// in float32, that is, up to 1 + 2⁻²².
+	const (
+		alpha = 1 + 0x1p-23
+		xv    = 0x1p-24 - 0x1p-47
+		yv    = 1 + 0x1p-23
+		want  = 1 + 0x1p-23
+	)
+	if double := float32(float64(alpha)*float64(xv) + float64(yv)); double == want {
+		t.Fatalf("double rounding unexpectedly correct")
+	}
+
+	y := []float32{yv, yv}
+	AxpyUnitaryFMA(alpha, []float32{xv, xv}, y)
+	for i, v := range y {
+		if v != want {
+			t.Errorf("unexpected AxpyUnitaryFMA result at %v: got %v, want %v", i, v, want)
+		}
+	}
+
+	y = []float32{yv, yv}
+	AxpyIncFMA(alpha, []float32{xv, xv}, y, 2, 1, 1, 0, 0)
+	for i, v := range y {
+		if v != want {
+			t.Errorf("unexpected AxpyIncFMA result at %v: got %v, want %v", i, v, want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+import "math"
+
+// AxpyUnitaryFMA is
+//  for i, v := range x {
+//  	y[i] = math.FMA(alpha, v, y[i])
+//  }
+func AxpyUnitaryFMA(alpha float64, x, y []float64) {
+	for i, v := range x {
+		y[i] = math.FMA(alpha, v, y[i])
+	}
+}
+
+// AxpyIncFMA is
+//  for i := 0; i < int(n); i++ {
+//  	y[iy] = math.FMA(alpha, x[ix], y[iy])
+//  	ix += incX
+//  	iy += incY
+//  }
+func AxpyIncFMA(alpha float64, x, y []float64, n, incX, incY, ix, iy uintptr) {
+	for i := 0; i < int(n); i++ {
+		y[iy] = math.FMA(alpha, x[ix], y[iy])
+		ix += incX
+		iy += incY
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+func TestAxpyUnitaryFMA(t *testing.T) {
+	const xGdVal, yGdVal = -1, 0.5
+	for i, test := range axpyTests {
+		for _, align := range align2 {
+			prefix := fmt.Sprintf("Test %v (x:%v y:%v)", i, align.x, align.y)
+			xgLn, ygLn := 4+align.x, 4+align.y
+			xg, yg := guardVector(test.x, xGdVal, xgLn), guardVector(test.y, yGdVal, ygLn)
+			x, y := xg[xgLn:len(xg)-xgLn], yg[ygLn:len(yg)-ygLn]
+			AxpyUnitaryFMA(test.alpha, x, y)
+			for i := range test.want {
+				if !scalar.Same(y[i], test.want[i]) {
+					t.Errorf(msgVal, prefix, i, y[i], test.want[i])
+				}
+			}
+			if !isValidGuard(xg, xGdVal, xgLn) {
+				t.Errorf(msgGuard, prefix, "x", xg[:xgLn], xg[len(xg)-xgLn:])
+			}
+			if !isValidGuard(yg, yGdVal, ygLn) {
+				t.Errorf(msgGuard, prefix, "y", yg[:ygLn], yg[len(yg)-ygLn:])
+			}
+			if !equalStrided(test.x, x, 1) {
+				t.Errorf("%v: modified read-only x argument", prefix)
+			}
+		}
+	}
+}
+
+func TestAxpyIncFMA(t *testing.T) {
+	const xGdVal, yGdVal = -1, 0.5
+	gdLn := 4
+	for i, test := range axpyTests {
+		n := len(test.x)
+		for _, inc := range newIncSet(-7, -4, -3, -2, -1, 1, 2, 3, 4, 7) {
+			var ix, iy int
+			if inc.x < 0 {
+				ix = (-n + 1) * inc.x
+			}
+			if inc.y < 0 {
+				iy = (-n + 1) * inc.y
+			}
+			prefix := fmt.Sprintf("test %v, inc.x = %v, inc.y = %v", i, inc.x, inc.y)
+			xg := guardIncVector(test.x, xGdVal, inc.x, gdLn)
+			yg := guardIncVector(test.y, yGdVal, inc.y, gdLn)
+			x, y := xg[gdLn:len(xg)-gdLn], yg[gdLn:len(yg)-gdLn]
+
+			AxpyIncFMA(test.alpha, x, y, uintptr(n),
+				uintptr(inc.x), uintptr(inc.y), uintptr(ix), uintptr(iy))
+
+			want := test.want
+			if inc.x*inc.y < 0 {
+				want = test.wantRev
+			}
+			if inc.y < 0 {
+				inc.y = -inc.y
+			}
+			for i := range want {
+				if !scalar.Same(y[i*inc.y], want[i]) {
+					t.Errorf(msgVal, prefix, i, y[iy+i*inc.y], want[i])
+				}
+			}
+			if !equalStrided(test.x, x, inc.x) {
+				t.Errorf("%v: modified read-only x argument", prefix)
+			}
+			checkValidIncGuard(t, xg, xGdVal, inc.x, gdLn)
+			checkValidIncGuard(t, yg, yGdVal, inc.y, gdLn)
+		}
+	}
+}
+
+func TestAxpyFMAAccuracy(t *testing.T) {
+	// alpha*x = 1 - 2⁻⁶⁰ exactly, which rounds to 1 in float64, so the
+	// unfused update loses the entire result while the fused update is exact.
+	alpha := 1 + math.Ldexp(1, -30)
+	x := []float64{1 - math.Ldexp(1, -30), 1 - math.Ldexp(1, -30)}
+	const want = -0x1p-60
+
+	// The explicit conversion forces rounding of the product.
+	if unfused := float64(alpha*x[0]) - 1; unfused == want {
+		t.Fatalf("unfused update unexpectedly exact")
+	}
+
+	y := []float64{-1, -1}
+	AxpyUnitaryFMA(alpha, x, y)
+	for i, v := range y {
+		if v != want {
+			t.Errorf("unexpected AxpyUnitaryFMA result at %v: got %v, want %v", i, v, want)
+		}
+	}
+
+	y = []float64{-1, -1}
+	AxpyIncFMA(alpha, x, y, 2, 1, 1, 0, 0)
+	for i, v := range y {
+		if v != want {
+			t.Errorf("unexpected AxpyIncFMA result at %v: got %v, want %v", i, v, want)
+		}
+	}
+}
@@ -38,6 +38,7 @@ func BenchmarkAxpyUnitary(t *testing.B) {
 		f    func(a float64, x, y []float64)
 	}{
 		{"AxpyUnitary", AxpyUnitary},
+		{"AxpyUnitaryFMA", AxpyUnitaryFMA},
 		{"NaiveAxpyUnitary", naiveaxpyu},
 	}
 	for _, test := range tests {
@@ -109,6 +110,7 @@ func BenchmarkAxpyInc(t *testing.B) {
 		f    func(alpha float64, x, y []float64, n, incX, incY, ix, iy uintptr)
 	}{
 		{"AxpyInc", AxpyInc},
+		{"AxpyIncFMA", AxpyIncFMA},
 		{"NaiveAxpyInc", naiveaxpyinc},
 	}
 	for _, test := range tests {
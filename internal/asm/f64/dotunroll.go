@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+// DotUnitaryUnroll4 computes the same sum as DotUnitary, but accumulates
+// it in four independent partial sums that are combined at the end:
+//  for i := 0; i < len(x)-3; i += 4 {
+//  	s0 += y[i] * x[i]
+//  	s1 += y[i+1] * x[i+1]
+//  	s2 += y[i+2] * x[i+2]
+//  	s3 += y[i+3] * x[i+3]
+//  }
+//  for ; i < len(x); i++ {
+//  	s0 += y[i] * x[i]
+//  }
+//  return (s0 + s1) + (s2 + s3)
+// The order of summation differs from that of DotUnitary, so the result
+// may differ in the last bits.
+func DotUnitaryUnroll4(x, y []float64) float64 {
+	y = y[:len(x)]
+	var s0, s1, s2, s3 float64
+	i := 0
+	for ; i < len(x)-3; i += 4 {
+		s0 += y[i] * x[i]
+		s1 += y[i+1] * x[i+1]
+		s2 += y[i+2] * x[i+2]
+		s3 += y[i+3] * x[i+3]
+	}
+	for ; i < len(x); i++ {
+		s0 += y[i] * x[i]
+	}
+	return (s0 + s1) + (s2 + s3)
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64_test
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+func TestDotUnitaryUnroll4(t *testing.T) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for n := 1; n <= 37; n++ {
+		prefix := fmt.Sprintf("n=%v", n)
+		xData := randSlice(n, 1, rnd)
+		yData := randSlice(n, 1, rnd)
+		want := DotUnitary(xData, yData)
+
+		x, xFront, xBack := newGuardedVector(xData, 1)
+		y, yFront, yBack := newGuardedVector(yData, 1)
+		got := DotUnitaryUnroll4(x, y)
+
+		if !allNaN(xFront) || !allNaN(xBack) {
+			t.Errorf("%v: out-of-bounds write to x argument", prefix)
+		}
+		if !allNaN(yFront) || !allNaN(yBack) {
+			t.Errorf("%v: out-of-bounds write to y argument", prefix)
+		}
+		if !equalStrided(xData, x, 1) || !equalStrided(yData, y, 1) {
+			t.Errorf("%v: modified read-only argument", prefix)
+		}
+		if !sameApprox(got, want, tol) {
+			t.Errorf("%v: unexpected dot product: got %v, want %v", prefix, got, want)
+		}
+	}
+}
+
+func BenchmarkDotUnitaryUnroll4(b *testing.B) {
+	for _, n := range []int{10, 100, 1000, 10000} {
+		x := randomSlice(n, 1)
+		y := randomSlice(n, 1)
+		b.Run(fmt.Sprintf("DotUnitary/n=%d", n), func(b *testing.B) {
+			var sum float64
+			for i := 0; i < b.N; i++ {
+				sum += DotUnitary(x, y)
+			}
+			benchSink = append(benchSink[:0], sum)
+		})
+		b.Run(fmt.Sprintf("DotUnitaryUnroll4/n=%d", n), func(b *testing.B) {
+			var sum float64
+			for i := 0; i < b.N; i++ {
+				sum += DotUnitaryUnroll4(x, y)
+			}
+			benchSink = append(benchSink[:0], sum)
+		})
+	}
+}
@@ -149,10 +149,19 @@ func TestGer(t *testing.T) {
 			}
 		}
 
-		for _, inc := range newIncSet(1, 2) {
+		for _, inc := range newIncSet(-3, -1, 1, 2) {
 			prefix := fmt.Sprintf("Test %v (%vx%v) inc(x:%v,y:%v)", i, m, n, inc.x, inc.y)
-			xg := guardIncVector(test.x, xGdVal, inc.x, gdLn)
-			yg := guardIncVector(test.y, yGdVal, inc.y, gdLn)
+			// A negative increment walks the vector from its end, so store
+			// the elements in reverse to keep the expected result unchanged.
+			tx, ty := test.x, test.y
+			if inc.x < 0 {
+				tx = reversed(tx)
+			}
+			if inc.y < 0 {
+				ty = reversed(ty)
+			}
+			xg := guardIncVector(tx, xGdVal, inc.x, gdLn)
+			yg := guardIncVector(ty, yGdVal, inc.y, gdLn)
 			x, y := xg[gdLn:len(xg)-gdLn], yg[gdLn:len(yg)-gdLn]
 			ag := guardVector(test.a, aGdVal, gdLn)
 			a := ag[gdLn : len(ag)-gdLn]
@@ -170,16 +179,25 @@ func TestGer(t *testing.T) {
 			if !isValidGuard(ag, aGdVal, gdLn) {
 				t.Errorf(msgGuard, prefix, "a", ag[:gdLn], ag[len(ag)-gdLn:])
 			}
-			if !equalStrided(test.x, x, inc.x) {
+			if !equalStrided(tx, x, inc.x) {
 				t.Errorf(msgReadOnly, prefix, "x")
 			}
-			if !equalStrided(test.y, y, inc.y) {
+			if !equalStrided(ty, y, inc.y) {
 				t.Errorf(msgReadOnly, prefix, "y")
 			}
 		}
 	}
 }
 
+// reversed returns a copy of s with its elements in reverse order.
+func reversed(s []float64) []float64 {
+	r := make([]float64, len(s))
+	for i, v := range s {
+		r[len(s)-1-i] = v
+	}
+	return r
+}
+
 func BenchmarkGer(t *testing.B) {
 	const alpha = 3
 	for _, dims := range newIncSet(3, 10, 30, 100, 300, 1e3, 3e3, 1e4) {
@@ -538,10 +538,13 @@ func dgemvcomp(t *testing.T, test DgemvCase, trans bool, cas DgemvSubcase, i int
 	a := ag[gdLn : len(ag)-gdLn]
 
 	lda := uintptr(test.n)
+	// y is passed with the trailing guard included so that writes past
+	// the end of the vector are detected.
+	yLong := y[:len(y)+gdLn]
 	if trans {
-		GemvT(uintptr(test.m), uintptr(test.n), cas.alpha, a, lda, x, 1, cas.beta, y, 1)
+		GemvT(uintptr(test.m), uintptr(test.n), cas.alpha, a, lda, x, 1, cas.beta, yLong, 1)
 	} else {
-		GemvN(uintptr(test.m), uintptr(test.n), cas.alpha, a, lda, x, 1, cas.beta, y, 1)
+		GemvN(uintptr(test.m), uintptr(test.n), cas.alpha, a, lda, x, 1, cas.beta, yLong, 1)
 	}
 	for i := range cas.want {
 		if !sameApprox(y[i], cas.want[i], tol) {